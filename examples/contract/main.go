@@ -13,7 +13,7 @@ var create = false
 
 func main() {
 	client, _ := ord.NewClient(
-		ord.WithBase("https://api-sandbox.ord.vk.com"),
+		ord.WithEnvironment(ord.EnvSandbox),
 		ord.WithToken(os.Getenv("TOKEN")),
 	)
 
@@ -41,7 +41,7 @@ func main() {
 			SubjectType:          ord.ContractSubjectTypeOther,
 		}
 
-		err = client.CreateContract(context.Background(), contractExternalID, contract)
+		_, err = client.CreateContract(context.Background(), contractExternalID, contract)
 		if err != nil {
 			log.Printf("Error creating contract: %v\n", err)
 		} else {
@@ -13,7 +13,7 @@ var create = true
 
 func main() {
 	client, _ := ord.NewClient(
-		ord.WithBase("https://api-sandbox.ord.vk.com"),
+		ord.WithEnvironment(ord.EnvSandbox),
 		ord.WithToken(os.Getenv("TOKEN")),
 	)
 
@@ -41,10 +41,10 @@ func main() {
 			Form:             ord.CreativeFormBanner,
 			TargetURLs:       &[]string{"https://example.com"},
 			MediaExternalIDs: &[]string{"test-media-001"},
-			PayType:          ord.StringPtr(ord.CreativePayTypeCPM),
+			PayType:          ord.Ptr(ord.CreativePayTypeCPM),
 		}
 
-		err = client.CreateCreativeV3(context.Background(), externalID, creative)
+		_, err = client.CreateCreativeV3(context.Background(), externalID, creative)
 		if err != nil {
 			log.Printf("Error creating creative: %v\n", err)
 		} else {
@@ -11,7 +11,7 @@ import (
 
 func main() {
 	client, _ := ord.NewClient(
-		ord.WithBase("https://api-sandbox.ord.vk.com"),
+		ord.WithEnvironment(ord.EnvSandbox),
 		ord.WithToken(os.Getenv("TOKEN")),
 	)
 
@@ -13,7 +13,7 @@ var create = true
 
 func main() {
 	client, _ := ord.NewClient(
-		ord.WithBase("https://api-sandbox.ord.vk.com"),
+		ord.WithEnvironment(ord.EnvSandbox),
 		ord.WithToken(os.Getenv("TOKEN")),
 	)
 
@@ -13,7 +13,7 @@ var create = true
 
 func main() {
 	client, _ := ord.NewClient(
-		ord.WithBase("https://api-sandbox.ord.vk.com"),
+		ord.WithEnvironment(ord.EnvSandbox),
 		ord.WithToken(os.Getenv("TOKEN")),
 	)
 
@@ -53,7 +53,7 @@ func main() {
 			URL:              ord.StringPtr("https://example.com"),
 		}
 
-		err = client.CreatePad(context.Background(), padExternalID, pad)
+		_, err = client.CreatePad(context.Background(), padExternalID, pad)
 		if err != nil {
 			log.Printf("Error creating pad: %v\n", err)
 		} else {
@@ -13,7 +13,7 @@ var create = false
 
 func main() {
 	client, _ := ord.NewClient(
-		ord.WithBase("https://api-sandbox.ord.vk.com"),
+		ord.WithEnvironment(ord.EnvSandbox),
 		ord.WithToken(os.Getenv("TOKEN")),
 	)
 
@@ -41,11 +41,11 @@ func main() {
 
 		person := ord.Person{
 			Name:             "Ковардин Артем Сергеевич",
-			Roles:            []string{"advertiser"},
+			Roles:            []ord.PersonRole{ord.PersonRoleAdvertiser},
 			JuridicalDetails: juridicalDetails,
 		}
 
-		err = client.CreatePerson(context.Background(), externalID, person)
+		_, err = client.CreatePerson(context.Background(), externalID, person)
 		if err != nil {
 			log.Printf("Error creating person: %v\n", err)
 		} else {
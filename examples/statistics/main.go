@@ -13,7 +13,7 @@ var create = false
 
 func main() {
 	client, _ := ord.NewClient(
-		ord.WithBase("https://api-sandbox.ord.vk.com"),
+		ord.WithEnvironment(ord.EnvSandbox),
 		ord.WithToken(os.Getenv("TOKEN")),
 	)
 
@@ -49,7 +49,7 @@ func main() {
 					InvoiceShowsCount:  &showsCount,
 					Amount:             &amount,
 					AmountPerEvent:     ord.StringPtr("0.96"),
-					PayType:            ord.StringPtr(ord.StatisticsPayTypeCPM),
+					PayType:            ord.Ptr(ord.StatisticsPayTypeCPM),
 					DateStartPlanned:   ord.StringPtr("2023-01-01"),
 					DateEndPlanned:     ord.StringPtr("2023-01-31"),
 					DateStartActual:    "2023-01-01",
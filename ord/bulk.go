@@ -0,0 +1,142 @@
+package ord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DefaultFetchConcurrency is the number of workers used by Fetch* methods unless WithFetchConcurrency is set
+const DefaultFetchConcurrency = 4
+
+// BulkError contains errors of separate items of a Fetch* call keyed by external ID
+type BulkError struct {
+	Errors map[string]error
+}
+
+func (e *BulkError) Error() string {
+	ids := slices.Sorted(maps.Keys(e.Errors))
+
+	msgs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", id, e.Errors[id]))
+	}
+
+	return fmt.Sprintf("failed to fetch %d items: %s", len(ids), strings.Join(msgs, "; "))
+}
+
+// Unwrap allows matching item errors with errors.Is, e.g. ErrNotFound
+func (e *BulkError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// IDs adapts a slice of external IDs to the argument of Fetch* methods
+func IDs(ids []string) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for _, id := range ids {
+			if !yield(id, nil) {
+				return
+			}
+		}
+	}
+}
+
+// fetchAll loads details of every ID with a bounded worker pool.
+// Failed items are collected into BulkError, an error of the ids sequence stops the feeding
+func fetchAll[T any](ctx context.Context, workers int, ids iter.Seq2[string, error], fetch func(ctx context.Context, id string) (*T, error)) (map[string]*T, error) {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		results = map[string]*T{}
+		failed  = map[string]error{}
+		queue   = make(chan string)
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for id := range queue {
+				item, err := fetch(ctx, id)
+
+				mu.Lock()
+				if err != nil {
+					failed[id] = err
+				} else {
+					results[id] = item
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var feedErr error
+
+feed:
+	for id, err := range ids {
+		if err != nil {
+			feedErr = fmt.Errorf("failed to list ids: %w", err)
+			break
+		}
+
+		select {
+		case queue <- id:
+		case <-ctx.Done():
+			feedErr = ctx.Err()
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	var bulkErr error
+	if len(failed) > 0 {
+		bulkErr = &BulkError{Errors: failed}
+	}
+
+	return results, errors.Join(feedErr, bulkErr)
+}
+
+func (c *Client) fetchConcurrencyOrDefault() int {
+	if c.fetchConcurrency > 0 {
+		return c.fetchConcurrency
+	}
+
+	return DefaultFetchConcurrency
+}
+
+// FetchPersons loads persons by external IDs, e.g. FetchPersons(ctx, IDs(ids)) or FetchPersons(ctx, c.AllPersons(ctx))
+func (c *Client) FetchPersons(ctx context.Context, ids iter.Seq2[string, error]) (map[string]*Person, error) {
+	return fetchAll(ctx, c.fetchConcurrencyOrDefault(), ids, c.GetPerson)
+}
+
+// FetchContracts loads contracts by external IDs
+func (c *Client) FetchContracts(ctx context.Context, ids iter.Seq2[string, error]) (map[string]*Contract, error) {
+	return fetchAll(ctx, c.fetchConcurrencyOrDefault(), ids, c.GetContract)
+}
+
+// FetchPads loads pads by external IDs
+func (c *Client) FetchPads(ctx context.Context, ids iter.Seq2[string, error]) (map[string]*Pad, error) {
+	return fetchAll(ctx, c.fetchConcurrencyOrDefault(), ids, c.GetPad)
+}
+
+// FetchCreatives loads creatives (v3) by external IDs
+func (c *Client) FetchCreatives(ctx context.Context, ids iter.Seq2[string, error]) (map[string]*Creative, error) {
+	return fetchAll(ctx, c.fetchConcurrencyOrDefault(), ids, c.GetCreativeV3)
+}
+
+// FetchInvoices loads invoices by external IDs
+func (c *Client) FetchInvoices(ctx context.Context, ids iter.Seq2[string, error]) (map[string]*Invoice, error) {
+	return fetchAll(ctx, c.fetchConcurrencyOrDefault(), ids, c.GetInvoice)
+}
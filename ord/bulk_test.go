@@ -0,0 +1,119 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_FetchContracts(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			prev := maxInFlight.Load()
+			if current <= prev || maxInFlight.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/v1/contract/")
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": "Not found", "errors": []}`)
+			return
+		}
+		json.NewEncoder(w).Encode(Contract{ClientExternalID: "client-" + id})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithFetchConcurrency(2),
+	)
+
+	ids := []string{"c1", "c2", "missing", "c3", "c4"}
+
+	contracts, err := client.FetchContracts(context.Background(), IDs(ids))
+	require.Error(t, err)
+
+	var bulkErr *BulkError
+	require.ErrorAs(t, err, &bulkErr)
+	assert.Len(t, bulkErr.Errors, 1)
+	assert.ErrorIs(t, bulkErr.Errors["missing"], ErrNotFound)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.Len(t, contracts, 4)
+	assert.Equal(t, "client-c3", contracts["c3"].ClientExternalID)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestClient_FetchPersons_FromList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/person" {
+			json.NewEncoder(w).Encode(PersonListResponse{ExternalIDs: []string{"p1", "p2"}, TotalItemsCount: 2, Limit: 100})
+			return
+		}
+		json.NewEncoder(w).Encode(Person{Name: strings.TrimPrefix(r.URL.Path, "/v1/person/")})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+	)
+
+	persons, err := client.FetchPersons(context.Background(), client.AllPersons(context.Background()))
+	require.NoError(t, err)
+	require.Len(t, persons, 2)
+	assert.Equal(t, "p1", persons["p1"].Name)
+	assert.Equal(t, "p2", persons["p2"].Name)
+}
+
+func TestClient_FetchPads_ListError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+	)
+
+	pads, err := client.FetchPads(context.Background(), client.AllPads(context.Background(), ""))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Contains(t, err.Error(), "failed to list ids")
+	assert.Empty(t, pads)
+}
+
+func TestBulkError(t *testing.T) {
+	err := &BulkError{Errors: map[string]error{
+		"b": fmt.Errorf("second"),
+		"a": fmt.Errorf("first"),
+	}}
+
+	assert.Equal(t, "failed to fetch 2 items: a: first; b: second", err.Error())
+}
+
+func TestWithFetchConcurrency(t *testing.T) {
+	client, err := NewClient()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultFetchConcurrency, client.fetchConcurrencyOrDefault())
+
+	_, err = NewClient(WithFetchConcurrency(0))
+	require.Error(t, err)
+}
@@ -26,7 +26,7 @@ func (c *Client) GetCIDList(ctx context.Context, offset, limit int) (*CIDListRes
 	path := fmt.Sprintf("/v1/cid?offset=%d&limit=%d", offset, limit)
 
 	var response CIDListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetCIDList", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get CID list: %w", err)
 	}
 
@@ -37,7 +37,7 @@ func (c *Client) GetCID(ctx context.Context, cidValue string) (*CID, error) {
 	path := fmt.Sprintf("/v1/cid/%s", cidValue)
 
 	var cid CID
-	if err := c.request(ctx, "GET", path, nil, &cid); err != nil {
+	if err := c.request(ctx, "GetCID", cidValue, "GET", path, nil, &cid); err != nil {
 		return nil, fmt.Errorf("failed to get CID: %w", err)
 	}
 
@@ -47,7 +47,7 @@ func (c *Client) GetCID(ctx context.Context, cidValue string) (*CID, error) {
 func (c *Client) CreateCID(ctx context.Context, cidValue string, cid CID) error {
 	path := fmt.Sprintf("/v1/cid/%s", cidValue)
 
-	if err := c.request(ctx, "PUT", path, cid, nil); err != nil {
+	if err := c.request(ctx, "CreateCID", cidValue, "PUT", path, cid, nil); err != nil {
 		return fmt.Errorf("failed to create CID: %w", err)
 	}
 
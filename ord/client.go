@@ -6,20 +6,47 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
+	"maps"
 	"net/http"
+	"slices"
+	"sync"
 )
 
 type Client struct {
-	base  string
-	http  *http.Client
-	token string
+	base   string
+	http   *http.Client
+	token  string
+	tokens TokenProvider
+	retry  *RetryPolicy
+	logger *slog.Logger
+
+	limiter       *RateLimiter
+	groupLimiters map[string]*RateLimiter
+
+	middlewares []Middleware
+
+	productionGuard  bool
+	productionWrites bool
+
+	recorder Recorder
+	validate bool
+
+	strictInfoMessages bool
+	invoiceGuard       bool
+
+	pageSize         int
+	fetchConcurrency int
+
+	// mu guards token and tokens
+	mu sync.RWMutex
 }
 
 func NewClient(options ...Option) (*Client, error) {
 	cl := &Client{
-		base: "https://api.ord.vk.com",
-		http: http.DefaultClient,
+		base:   string(EnvProduction),
+		http:   http.DefaultClient,
+		logger: slog.Default(),
 	}
 
 	for _, o := range options {
@@ -31,64 +58,182 @@ func NewClient(options ...Option) (*Client, error) {
 	return cl, nil
 }
 
-func (c *Client) SetToken(token string) {
-	c.token = token
+// clone returns a client sharing the HTTP client, limiters, logger and middlewares,
+// the map of group limiters is copied so the clone can add its own groups
+func (c *Client) clone() *Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return &Client{
+		base:          c.base,
+		http:          c.http,
+		token:         c.token,
+		tokens:        c.tokens,
+		retry:         c.retry,
+		logger:        c.logger,
+		limiter:       c.limiter,
+		groupLimiters: maps.Clone(c.groupLimiters),
+		middlewares:   slices.Clone(c.middlewares),
+
+		productionGuard:  c.productionGuard,
+		productionWrites: c.productionWrites,
+
+		recorder: c.recorder,
+		validate: c.validate,
+
+		strictInfoMessages: c.strictInfoMessages,
+		invoiceGuard:       c.invoiceGuard,
+
+		pageSize:         c.pageSize,
+		fetchConcurrency: c.fetchConcurrency,
+	}
 }
 
 // request performs an HTTP request to the ORD VK API
-func (c *Client) request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	url := c.base + path
+func (c *Client) request(ctx context.Context, operation, externalID, method, path string, body interface{}, result interface{}) error {
+	return c.call(ctx, &Call{
+		Operation:  operation,
+		Method:     method,
+		Path:       path,
+		ExternalID: externalID,
+		Body:       body,
+		Result:     result,
+	})
+}
 
-	var req *http.Request
-	var err error
+// call passes the call through the middleware chain
+func (c *Client) call(ctx context.Context, call *Call) error {
+	if err := c.guard(call); err != nil {
+		return err
+	}
 
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
+	if c.validate {
+		if err := validateBody(call.Body); err != nil {
+			return err
+		}
+	}
+
+	return chain(c.execute, c.middlewares)(ctx, call)
+}
+
+// execute encodes the body, sends the request and decodes the response into call.Result
+func (c *Client) execute(ctx context.Context, call *Call) error {
+	if c.recorder != nil && isMutating(call) {
+		return c.dryRun(ctx, call)
+	}
+
+	var payload []byte
+	contentType := call.ContentType
+
+	switch body := call.Body.(type) {
+	case nil:
+	case []byte:
+		payload = body
+	default:
+		var err error
+		payload, err = json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
 
-		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
-		if err != nil {
-			return fmt.Errorf("failed to create request: %w", err)
+		contentType = "application/json"
+	}
+
+	respBody, err := c.do(ctx, call.Method, call.Path, payload, contentType)
+	if err != nil {
+		return err
+	}
+
+	switch result := call.Result.(type) {
+	case nil:
+	case *[]byte:
+		*result = respBody
+	default:
+		if len(respBody) > 0 {
+			if err := json.Unmarshal(respBody, result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
 		}
+	}
 
-		req.Header.Set("Content-Type", "application/json")
-	} else {
-		req, err = http.NewRequestWithContext(ctx, method, url, nil)
+	return nil
+}
+
+// do sends the raw request body and returns the raw response body, retrying transient failures
+func (c *Client) do(ctx context.Context, method, path string, body []byte, contentType string) ([]byte, error) {
+	attempts := 1
+	if c.retry != nil && c.retry.MaxAttempts > 1 && c.retry.allowed(ctx, method) {
+		attempts = c.retry.MaxAttempts
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.wait(ctx, path); err != nil {
+			return nil, fmt.Errorf("failed to wait for rate limiter: %w", err)
+		}
+
+		token, err := c.authToken(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to create request: %w", err)
+			return nil, fmt.Errorf("failed to get token: %w", err)
+		}
+
+		respBody, header, statusCode, err := c.send(ctx, method, path, token, body, contentType)
+		if err == nil && statusCode >= 200 && statusCode < 300 {
+			return respBody, nil
 		}
+
+		if attempt+1 >= attempts || !c.retry.retryable(err, statusCode) {
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, newAPIError(statusCode, respBody)
+		}
+
+		if err := sleep(ctx, c.retry.backoff(attempt, header)); err != nil {
+			return nil, fmt.Errorf("failed to perform request: %w", err)
+		}
+	}
+}
+
+// send performs a single HTTP request attempt
+func (c *Client) send(ctx context.Context, method, path, token string, body []byte, contentType string) ([]byte, http.Header, int, error) {
+	url := c.base + path
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
 	resp, err := c.http.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to perform request: %w", err)
+		c.logRequest(ctx, method, path, contentType, body, 0, nil, err)
+		return nil, nil, 0, fmt.Errorf("failed to perform request: %w", err)
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
-			log.Println("error on close body", err)
+			c.logger.WarnContext(ctx, "error on close body", slog.String("error", err.Error()))
 		}
 	}()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, resp.Header, resp.StatusCode, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
-	}
+	c.logRequest(ctx, method, path, contentType, body, resp.StatusCode, respBody, nil)
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
-		}
-	}
-
-	return nil
+	return respBody, resp.Header, resp.StatusCode, nil
 }
@@ -3,58 +3,156 @@ package ord
 import (
 	"context"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 )
 
+// ContractType is the type of a contract
+type ContractType string
+
 const (
-	ContractTypeService    = "service"    // договор оказания услуг.
-	ContractTypeMediation  = "mediation"  // посреднический договор. Требует заполнения поля action_type.
-	ContractTypeAdditional = "additional" // дополнительное соглашение. Требует заполнения поля parent_contract_external_id.
+	ContractTypeService    ContractType = "service"    // договор оказания услуг.
+	ContractTypeMediation  ContractType = "mediation"  // посреднический договор. Требует заполнения поля action_type.
+	ContractTypeAdditional ContractType = "additional" // дополнительное соглашение. Требует заполнения поля parent_contract_external_id.
 )
 
+// AllContractTypes returns all known contract types
+func AllContractTypes() []ContractType {
+	return []ContractType{ContractTypeService, ContractTypeMediation, ContractTypeAdditional}
+}
+
+// ParseContractType returns an error for unknown contract types
+func ParseContractType(s string) (ContractType, error) {
+	return parseEnum("contract type", s, AllContractTypes())
+}
+
+func (t ContractType) Valid() bool {
+	return slices.Contains(AllContractTypes(), t)
+}
+
+func (t ContractType) String() string {
+	return string(t)
+}
+
+// ContractActionType is the action of the mediator in a mediation contract
+type ContractActionType string
+
 const (
-	ContractActionTypeDistribution = "distribution" // распространение рекламы.
-	ContractActionTypeConclude     = "conclude"     // заключение договоров.
-	ContractActionTypeCommercial   = "commercial"   // коммерческое представительство.
-	ContractActionTypeOther        = "other"        // иное.
+	ContractActionTypeDistribution ContractActionType = "distribution" // распространение рекламы.
+	ContractActionTypeConclude     ContractActionType = "conclude"     // заключение договоров.
+	ContractActionTypeCommercial   ContractActionType = "commercial"   // коммерческое представительство.
+	ContractActionTypeOther        ContractActionType = "other"        // иное.
 )
 
+// AllContractActionTypes returns all known contract action types
+func AllContractActionTypes() []ContractActionType {
+	return []ContractActionType{ContractActionTypeDistribution, ContractActionTypeConclude, ContractActionTypeCommercial, ContractActionTypeOther}
+}
+
+// ParseContractActionType returns an error for unknown contract action types
+func ParseContractActionType(s string) (ContractActionType, error) {
+	return parseEnum("contract action type", s, AllContractActionTypes())
+}
+
+func (t ContractActionType) Valid() bool {
+	return slices.Contains(AllContractActionTypes(), t)
+}
+
+func (t ContractActionType) String() string {
+	return string(t)
+}
+
+// ContractSubjectType is the subject of a contract
+type ContractSubjectType string
+
 const (
-	ContractSubjectTypeRepresentation  = "representation"   // представительство.
-	ContractSubjectTypeOrgDistribution = "org_distribution" // организация распространения рекламы.
-	ContractSubjectTypeMediation       = "mediation"        // посредничество.
-	ContractSubjectTypeDistribution    = "distribution"     // распространение рекламы.
-	ContractSubjectTypeOther           = "other"            // иное.
+	ContractSubjectTypeRepresentation  ContractSubjectType = "representation"   // представительство.
+	ContractSubjectTypeOrgDistribution ContractSubjectType = "org_distribution" // организация распространения рекламы.
+	ContractSubjectTypeMediation       ContractSubjectType = "mediation"        // посредничество.
+	ContractSubjectTypeDistribution    ContractSubjectType = "distribution"     // распространение рекламы.
+	ContractSubjectTypeOther           ContractSubjectType = "other"            // иное.
 )
 
+// AllContractSubjectTypes returns all known contract subject types
+func AllContractSubjectTypes() []ContractSubjectType {
+	return []ContractSubjectType{
+		ContractSubjectTypeRepresentation,
+		ContractSubjectTypeOrgDistribution,
+		ContractSubjectTypeMediation,
+		ContractSubjectTypeDistribution,
+		ContractSubjectTypeOther,
+	}
+}
+
+// ParseContractSubjectType returns an error for unknown contract subject types
+func ParseContractSubjectType(s string) (ContractSubjectType, error) {
+	return parseEnum("contract subject type", s, AllContractSubjectTypes())
+}
+
+func (t ContractSubjectType) Valid() bool {
+	return slices.Contains(AllContractSubjectTypes(), t)
+}
+
+func (t ContractSubjectType) String() string {
+	return string(t)
+}
+
+// ContractFlag is an additional property of a contract
+type ContractFlag string
+
 const (
 	// vat_included — все налоги (если есть) включены в сумму договора. Обязателен для значений amount > 0.
-	ContractFlagVatIncluded = "vat_included"
+	ContractFlagVatIncluded ContractFlag = "vat_included"
 	// contractor_is_creatives_reporter — подрядчик обязуется вести учёт креативов.
 	// Значение можно указать, только если contractor_external_id — рекламная система.
-	ContractFlagContractorIsCreativesReporter = "contractor_is_creatives_reporter"
+	ContractFlagContractorIsCreativesReporter ContractFlag = "contractor_is_creatives_reporter"
 	//  деньги поступают от подрядчика (исполнителя) клиенту (заказчику).
 	// Значение можно указать, только если поле type принимает значение mediation.
-	ContractFlagAgentActingForPublisher = "agent_acting_for_publisher"
+	ContractFlagAgentActingForPublisher ContractFlag = "agent_acting_for_publisher"
 	// рекламный сбор в размере 3% за всю цепочку распространения рекламы оплачивает
 	// исполнитель по этому договору. Значение можно указать, только если поле type
 	// принимает значение mediation и подрядчик (исполнитель) не иностранный контрагент.
 	// Несовместим с флагом agent_acting_for_publisher.
-	ContractFlagIsChargePaidByAgent = "is_charge_paid_by_agent"
+	ContractFlagIsChargePaidByAgent ContractFlag = "is_charge_paid_by_agent"
 )
 
+// AllContractFlags returns all known contract flags
+func AllContractFlags() []ContractFlag {
+	return []ContractFlag{
+		ContractFlagVatIncluded,
+		ContractFlagContractorIsCreativesReporter,
+		ContractFlagAgentActingForPublisher,
+		ContractFlagIsChargePaidByAgent,
+	}
+}
+
+// ParseContractFlag returns an error for unknown contract flags
+func ParseContractFlag(s string) (ContractFlag, error) {
+	return parseEnum("contract flag", s, AllContractFlags())
+}
+
+func (f ContractFlag) Valid() bool {
+	return slices.Contains(AllContractFlags(), f)
+}
+
+func (f ContractFlag) String() string {
+	return string(f)
+}
+
 // Contract represents a contract (договор) in the ORD system
 type Contract struct {
-	CreateDate               string   `json:"create_date,omitempty"`
-	Type                     string   `json:"type"`
-	ClientExternalID         string   `json:"client_external_id"`
-	ContractorExternalID     string   `json:"contractor_external_id"`
-	ActionType               *string  `json:"action_type,omitempty"`
-	SubjectType              string   `json:"subject_type"`
-	Date                     string   `json:"date"`
-	DateEnd                  *string  `json:"date_end,omitempty"`
-	Serial                   *string  `json:"serial,omitempty"`
-	Flags                    []string `json:"flags,omitempty"`
-	ParentContractExternalID *string  `json:"parent_contract_external_id,omitempty"`
+	CreateDate               string              `json:"create_date,omitempty"`
+	Type                     ContractType        `json:"type"`
+	ClientExternalID         string              `json:"client_external_id"`
+	ContractorExternalID     string              `json:"contractor_external_id"`
+	ActionType               *ContractActionType `json:"action_type,omitempty"`
+	SubjectType              ContractSubjectType `json:"subject_type"`
+	Date                     string              `json:"date"`
+	DateEnd                  *string             `json:"date_end,omitempty"`
+	Serial                   *string             `json:"serial,omitempty"`
+	Flags                    []ContractFlag      `json:"flags,omitempty"`
+	ParentContractExternalID *string             `json:"parent_contract_external_id,omitempty"`
 
 	// 	maxLength: 29
 	// minLength: 1
@@ -75,24 +173,56 @@ type ContractListResponse struct {
 }
 
 type CreateContractRequest struct {
-	Type                     string   `json:"type"`
-	ClientExternalID         string   `json:"client_external_id"`
-	ContractorExternalID     string   `json:"contractor_external_id"`
-	Date                     string   `json:"date"`
-	DateEnd                  *string  `json:"date_end,omitempty"`
-	Serial                   *string  `json:"serial,omitempty"`
-	ActionType               *string  `json:"action_type,omitempty"`
-	SubjectType              string   `json:"subject_type"`
-	Flags                    []string `json:"flags,omitempty"`
-	ParentContractExternalID *string  `json:"parent_contract_external_id,omitempty"`
-	Amount                   *string  `json:"amount,omitempty"`
+	Type                     ContractType        `json:"type"`
+	ClientExternalID         string              `json:"client_external_id"`
+	ContractorExternalID     string              `json:"contractor_external_id"`
+	Date                     string              `json:"date"`
+	DateEnd                  *string             `json:"date_end,omitempty"`
+	Serial                   *string             `json:"serial,omitempty"`
+	ActionType               *ContractActionType `json:"action_type,omitempty"`
+	SubjectType              ContractSubjectType `json:"subject_type"`
+	Flags                    []ContractFlag      `json:"flags,omitempty"`
+	ParentContractExternalID *string             `json:"parent_contract_external_id,omitempty"`
+	Amount                   *string             `json:"amount,omitempty"`
+}
+
+// ContractRequest is CreateContractRequest with typed dates
+type ContractRequest struct {
+	Type                     ContractType
+	ClientExternalID         string
+	ContractorExternalID     string
+	Date                     Date
+	DateEnd                  *Date
+	Serial                   *string
+	ActionType               *ContractActionType
+	SubjectType              ContractSubjectType
+	Flags                    []ContractFlag
+	ParentContractExternalID *string
+	Amount                   *string
+}
+
+// Request converts the typed request into CreateContractRequest for CreateContract
+func (r ContractRequest) Request() CreateContractRequest {
+	return CreateContractRequest{
+		Type:                     r.Type,
+		ClientExternalID:         r.ClientExternalID,
+		ContractorExternalID:     r.ContractorExternalID,
+		Date:                     r.Date.String(),
+		DateEnd:                  dateString(r.DateEnd),
+		Serial:                   r.Serial,
+		ActionType:               r.ActionType,
+		SubjectType:              r.SubjectType,
+		Flags:                    r.Flags,
+		ParentContractExternalID: r.ParentContractExternalID,
+		Amount:                   r.Amount,
+	}
 }
 
 func (c *Client) GetContracts(ctx context.Context, offset, limit int) (*ContractListResponse, error) {
 	path := fmt.Sprintf("/v1/contract?offset=%d&limit=%d", offset, limit)
 
 	var response ContractListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetContracts", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get contracts: %w", err)
 	}
 
@@ -103,29 +233,178 @@ func (c *Client) GetContract(ctx context.Context, externalID string) (*Contract,
 	path := fmt.Sprintf("/v1/contract/%s", externalID)
 
 	var contract Contract
-	if err := c.request(ctx, "GET", path, nil, &contract); err != nil {
+	if err := c.request(ctx, "GetContract", externalID, "GET", path, nil, &contract); err != nil {
 		return nil, fmt.Errorf("failed to get contract: %w", err)
 	}
 
 	return &contract, nil
 }
 
-func (c *Client) CreateContract(ctx context.Context, externalID string, contract CreateContractRequest) error {
+// CreateContractOptions are query parameters of CreateContractWithOptions
+type CreateContractOptions struct {
+	// UpdateAdditionalContractsParties makes the API set client and contractor of all
+	// additional contracts to the parties of this contract
+	UpdateAdditionalContractsParties bool
+}
+
+// CreateContract creates or updates the contract, with WithValidation the request is validated before the PUT
+func (c *Client) CreateContract(ctx context.Context, externalID string, contract CreateContractRequest) (*PutResult, error) {
+	return c.CreateContractWithOptions(ctx, externalID, contract, CreateContractOptions{})
+}
+
+// CreateContractWithOptions is CreateContract with additional query parameters
+func (c *Client) CreateContractWithOptions(ctx context.Context, externalID string, contract CreateContractRequest, opts CreateContractOptions) (*PutResult, error) {
 	path := fmt.Sprintf("/v1/contract/%s", externalID)
+	if opts.UpdateAdditionalContractsParties {
+		path += "?update_additional_contracts_parties=true"
+	}
 
-	if err := c.request(ctx, "PUT", path, contract, nil); err != nil {
-		return fmt.Errorf("failed to create contract: %w", err)
+	var result PutResult
+	if err := c.request(ctx, "CreateContract", externalID, "PUT", path, contract, &result); err != nil {
+		return nil, fmt.Errorf("failed to create contract: %w", err)
 	}
 
-	return nil
+	if err := c.checkInfoMessages(&result); err != nil {
+		return &result, fmt.Errorf("failed to create contract: %w", err)
+	}
+
+	return &result, nil
+}
+
+// AdditionalContractChange is an additional contract of a framework contract and the parties
+// it would get from the new version of the framework contract
+type AdditionalContractChange struct {
+	ExternalID           string
+	Contract             *Contract // текущая версия доп. соглашения.
+	ClientExternalID     string    // заказчик после обновления.
+	ContractorExternalID string    // исполнитель после обновления.
+}
+
+// Changed reports whether the parties of the additional contract will change
+func (c AdditionalContractChange) Changed() bool {
+	return c.Contract.ClientExternalID != c.ClientExternalID || c.Contract.ContractorExternalID != c.ContractorExternalID
+}
+
+// AffectedAdditionalContracts returns additional contracts whose ParentContractExternalID points
+// at the contract with externalID, sorted by external ID, together with the parties they get when
+// the contract is updated to contract with UpdateAdditionalContractsParties.
+// The API can't filter contracts by parent, so all contracts are loaded with FetchContracts.
+// If some contracts fail to load, the changes found among the loaded ones are returned
+// together with the error, which wraps *BulkError
+func (c *Client) AffectedAdditionalContracts(ctx context.Context, externalID string, contract CreateContractRequest) ([]AdditionalContractChange, error) {
+	parent, err := c.GetContract(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if !parent.HasAdditionalContracts {
+		return nil, nil
+	}
+
+	contracts, fetchErr := c.FetchContracts(ctx, c.AllContracts(ctx))
+	if fetchErr != nil {
+		fetchErr = fmt.Errorf("failed to find additional contracts: %w", fetchErr)
+	}
+
+	var changes []AdditionalContractChange
+	for _, id := range slices.Sorted(maps.Keys(contracts)) {
+		additional := contracts[id]
+		if additional.ParentContractExternalID == nil || *additional.ParentContractExternalID != externalID {
+			continue
+		}
+
+		changes = append(changes, AdditionalContractChange{
+			ExternalID:           id,
+			Contract:             additional,
+			ClientExternalID:     contract.ClientExternalID,
+			ContractorExternalID: contract.ContractorExternalID,
+		})
+	}
+
+	return changes, fetchErr
 }
 
 func (c *Client) RequestCID(ctx context.Context, externalID string) error {
 	path := fmt.Sprintf("/v1/contract/%s/create_cid", externalID)
 
-	if err := c.request(ctx, "POST", path, nil, nil); err != nil {
+	if err := c.request(ctx, "RequestCID", externalID, "POST", path, nil, nil); err != nil {
 		return fmt.Errorf("failed to request CID: %w", err)
 	}
 
 	return nil
 }
+
+var amountRe = regexp.MustCompile(`^\d{1,12}(\.\d{1,8})?$`)
+
+// Validate checks the business rules of the contract described in the API docs:
+// required and allowed fields for mediation and additional contracts, flags, amount format and dates
+func (r CreateContractRequest) Validate() error {
+	v := &validator{}
+
+	switch {
+	case r.Type == "":
+		v.add("type", "is required")
+	case !r.Type.Valid():
+		v.add("type", "unknown type %q", r.Type)
+	case r.Type == ContractTypeMediation && r.ActionType == nil:
+		v.add("action_type", "is required for type %s", ContractTypeMediation)
+	case r.Type != ContractTypeMediation && r.ActionType != nil:
+		v.add("action_type", "is allowed only for type %s", ContractTypeMediation)
+	}
+
+	if r.Type == ContractTypeAdditional && (r.ParentContractExternalID == nil || *r.ParentContractExternalID == "") {
+		v.add("parent_contract_external_id", "is required for type %s", ContractTypeAdditional)
+	}
+
+	if r.ClientExternalID == "" {
+		v.add("client_external_id", "is required")
+	}
+	if r.ContractorExternalID == "" {
+		v.add("contractor_external_id", "is required")
+	}
+
+	if r.ActionType != nil && !r.ActionType.Valid() {
+		v.add("action_type", "unknown action type %q", *r.ActionType)
+	}
+
+	if r.SubjectType != "" && !r.SubjectType.Valid() {
+		v.add("subject_type", "unknown subject type %q", r.SubjectType)
+	}
+
+	for _, flag := range r.Flags {
+		switch {
+		case !flag.Valid():
+			v.add("flags", "unknown flag %q", flag)
+		case (flag == ContractFlagAgentActingForPublisher || flag == ContractFlagIsChargePaidByAgent) && r.Type != ContractTypeMediation:
+			v.add("flags", "%s is allowed only for type %s", flag, ContractTypeMediation)
+		}
+	}
+
+	if slices.Contains(r.Flags, ContractFlagAgentActingForPublisher) && slices.Contains(r.Flags, ContractFlagIsChargePaidByAgent) {
+		v.add("flags", "%s and %s are mutually exclusive", ContractFlagAgentActingForPublisher, ContractFlagIsChargePaidByAgent)
+	}
+
+	if r.Amount != nil {
+		if !amountRe.MatchString(*r.Amount) {
+			v.add("amount", "must be a number with up to 12 integer and 8 fractional digits")
+		} else if amount, _ := ParseMoney(*r.Amount); amount.Sign() > 0 && !slices.Contains(r.Flags, ContractFlagVatIncluded) {
+			v.add("flags", "%s is required when amount > 0", ContractFlagVatIncluded)
+		}
+	}
+
+	date, err := ParseDate(r.Date)
+	if err != nil {
+		v.add("date", "must be a date in YYYY-MM-DD format")
+	}
+
+	if r.DateEnd != nil {
+		end, endErr := ParseDate(*r.DateEnd)
+		switch {
+		case endErr != nil:
+			v.add("date_end", "must be a date in YYYY-MM-DD format")
+		case err == nil && end.Before(date):
+			v.add("date_end", "must not be before date")
+		}
+	}
+
+	return v.err()
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -122,7 +124,7 @@ func TestClient_CreateContract(t *testing.T) {
 		WithToken("test-token"),
 	)
 
-	err := client.CreateContract(context.Background(), "contract1", testContract)
+	_, err := client.CreateContract(context.Background(), "contract1", testContract)
 	require.NoError(t, err, "CreateContract should not return an error")
 }
 
@@ -143,3 +145,156 @@ func TestClient_RequestCID(t *testing.T) {
 	err := client.RequestCID(context.Background(), "contract1")
 	require.NoError(t, err, "RequestCID should not return an error")
 }
+
+func TestCreateContractRequest_Validate(t *testing.T) {
+	valid := CreateContractRequest{
+		Type:                 ContractTypeMediation,
+		ClientExternalID:     "client1",
+		ContractorExternalID: "contractor1",
+		ActionType:           Ptr(ContractActionTypeDistribution),
+		SubjectType:          ContractSubjectTypeDistribution,
+		Date:                 "2024-01-01",
+		DateEnd:              Ptr("2024-12-31"),
+		Flags:                []ContractFlag{ContractFlagVatIncluded, ContractFlagAgentActingForPublisher},
+		Amount:               Ptr("500.5"),
+	}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(r *CreateContractRequest)
+		field  string
+	}{
+		{"mediation without action type", func(r *CreateContractRequest) { r.ActionType = nil }, "action_type"},
+		{"action type without mediation", func(r *CreateContractRequest) {
+			r.Type = ContractTypeService
+			r.Flags = []ContractFlag{ContractFlagVatIncluded}
+		}, "action_type"},
+		{"additional without parent", func(r *CreateContractRequest) {
+			r.Type = ContractTypeAdditional
+			r.Flags = []ContractFlag{ContractFlagVatIncluded}
+		}, "parent_contract_external_id"},
+		{"amount without vat flag", func(r *CreateContractRequest) { r.Flags = nil }, "flags"},
+		{"mediation-only flag", func(r *CreateContractRequest) { r.Type = ContractTypeService }, "flags"},
+		{"exclusive flags", func(r *CreateContractRequest) { r.Flags = append(r.Flags, ContractFlagIsChargePaidByAgent) }, "flags"},
+		{"bad amount", func(r *CreateContractRequest) { r.Amount = Ptr("1.123456789") }, "amount"},
+		{"bad date", func(r *CreateContractRequest) { r.Date = "01.01.2024" }, "date"},
+		{"date end before date", func(r *CreateContractRequest) { r.DateEnd = Ptr("2023-12-31") }, "date_end"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := valid
+			request.Flags = slices.Clone(valid.Flags)
+			tt.modify(&request)
+
+			var verrs ValidationErrors
+			require.ErrorAs(t, request.Validate(), &verrs)
+			assert.True(t, verrs.Has(tt.field), verrs.Error())
+		})
+	}
+
+	zero := valid
+	zero.Amount = Ptr("0")
+	zero.Flags = nil
+	zero.Type = ContractTypeService
+	zero.ActionType = nil
+	assert.NoError(t, zero.Validate(), "vat_included is not required for zero amount")
+}
+
+func TestClient_CreateContract_WithValidation(t *testing.T) {
+	var calls atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithValidation(),
+	)
+
+	_, err := client.CreateContract(context.Background(), "contract1", CreateContractRequest{Type: ContractTypeMediation})
+	require.Error(t, err)
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+	assert.True(t, verrs.Has("action_type"))
+	assert.Equal(t, int64(0), calls.Load())
+}
+
+func TestClient_CreateContractWithOptions(t *testing.T) {
+	var query string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithBase(server.URL), WithToken("test-token"))
+
+	_, err := client.CreateContractWithOptions(context.Background(), "contract1", CreateContractRequest{}, CreateContractOptions{UpdateAdditionalContractsParties: true})
+	require.NoError(t, err)
+	assert.Equal(t, "update_additional_contracts_parties=true", query)
+
+	_, err = client.CreateContract(context.Background(), "contract1", CreateContractRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, query)
+}
+
+func TestClient_AffectedAdditionalContracts(t *testing.T) {
+	contracts := map[string]Contract{
+		"frame": {Type: ContractTypeService, ClientExternalID: "client1", ContractorExternalID: "contractor1", HasAdditionalContracts: true},
+		"add1":  {Type: ContractTypeAdditional, ClientExternalID: "client1", ContractorExternalID: "contractor1", ParentContractExternalID: Ptr("frame")},
+		"add2":  {Type: ContractTypeAdditional, ClientExternalID: "client2", ContractorExternalID: "contractor1", ParentContractExternalID: Ptr("frame")},
+		"other": {Type: ContractTypeAdditional, ClientExternalID: "client1", ContractorExternalID: "contractor1", ParentContractExternalID: Ptr("frame2")},
+		"plain": {Type: ContractTypeService, ClientExternalID: "client1", ContractorExternalID: "contractor1"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/contract" {
+			json.NewEncoder(w).Encode(ContractListResponse{
+				ExternalIDs:     []string{"add1", "add2", "broken", "frame", "other", "plain"},
+				TotalItemsCount: 6,
+				Limit:           100,
+			})
+			return
+		}
+
+		contract, ok := contracts[r.URL.Path[len("/v1/contract/"):]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"Not found"}`)
+			return
+		}
+		json.NewEncoder(w).Encode(contract)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithBase(server.URL), WithToken("test-token"))
+
+	changes, err := client.AffectedAdditionalContracts(context.Background(), "frame", CreateContractRequest{
+		ClientExternalID:     "client2",
+		ContractorExternalID: "contractor1",
+	})
+	var bulkErr *BulkError
+	require.ErrorAs(t, err, &bulkErr, "contracts failed to load should be reported")
+	assert.Contains(t, bulkErr.Errors, "broken")
+	require.Len(t, changes, 2, "changes among loaded contracts should be returned")
+
+	assert.Equal(t, "add1", changes[0].ExternalID)
+	assert.True(t, changes[0].Changed())
+	assert.Equal(t, "add2", changes[1].ExternalID)
+	assert.False(t, changes[1].Changed())
+
+	changes, err = client.AffectedAdditionalContracts(context.Background(), "plain", CreateContractRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	_, err = client.AffectedAdditionalContracts(context.Background(), "missing", CreateContractRequest{})
+	assert.ErrorIs(t, err, ErrNotFound, "unknown parent should not look like a contract without additional contracts")
+}
@@ -4,59 +4,138 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 )
 
+// CreativePayType is the pricing model of a creative
+type CreativePayType string
+
 const (
-	CreativePayTypeCPA   = "cpa"   // Cost Per Action, цена за действие.
-	CreativePayTypeCPC   = "cpc"   // Cost Per Click, цена за клик.
-	CreativePayTypeCPM   = "cpm"   // Cost Per Millennium, цена за 1 000 показов.
-	CreativePayTypeOther = "other" // иное.
+	CreativePayTypeCPA   CreativePayType = "cpa"   // Cost Per Action, цена за действие.
+	CreativePayTypeCPC   CreativePayType = "cpc"   // Cost Per Click, цена за клик.
+	CreativePayTypeCPM   CreativePayType = "cpm"   // Cost Per Millennium, цена за 1 000 показов.
+	CreativePayTypeOther CreativePayType = "other" // иное.
 )
 
+// AllCreativePayTypes returns all known creative pay types
+func AllCreativePayTypes() []CreativePayType {
+	return []CreativePayType{CreativePayTypeCPA, CreativePayTypeCPC, CreativePayTypeCPM, CreativePayTypeOther}
+}
+
+// ParseCreativePayType returns an error for unknown creative pay types
+func ParseCreativePayType(s string) (CreativePayType, error) {
+	return parseEnum("creative pay type", s, AllCreativePayTypes())
+}
+
+func (t CreativePayType) Valid() bool {
+	return slices.Contains(AllCreativePayTypes(), t)
+}
+
+func (t CreativePayType) String() string {
+	return string(t)
+}
+
+// CreativeForm is the form of distribution of a creative
+type CreativeForm string
+
 const (
-	CreativeFormBanner                     = "banner"                         // баннер.
-	CreativeFormText                       = "text"                           // текстовый блок.
-	CreativeFormAudio                      = "audio"                          // аудиозапись.
-	CreativeFormVideo                      = "video"                          // видеоролик.
-	CreativeFormLiveAudio                  = "live_audio"                     // аудиотрансляция в прямом эфире.
-	CreativeFormLiveVideo                  = "live_video"                     // видеотрансляция в прямом эфире.
-	CreativeFormTextVideoBlock             = "text_video_block"               // текстовый блок с видео
-	CreativeFormTextGraphicBlock           = "text_graphic_block"             // текстово-графический блок
-	CreativeFormTextAudioBlock             = "text_audio_block"               // текстовый блок с аудио
-	CreativeFormTextGraphicVideoBlock      = "text_graphic_video_block"       // текстово-графический блок с видео
-	CreativeFormTextAudioVideoBlock        = "text_audio_video_block"         // текстовый блок с аудио и видео
-	CreativeFormTextGraphicAudioBlock      = "text_graphic_audio_block"       // текстово-графический блок с видео
-	CreativeFormTextGraphicAudioVideoBlock = "text_graphic_audio_video_block" // текстово-графический блок с аудио и видео
-	CreativeFormBannerHTML5                = "banner_html5"                   // HTML5-баннер
+	CreativeFormBanner                     CreativeForm = "banner"                         // баннер.
+	CreativeFormText                       CreativeForm = "text"                           // текстовый блок.
+	CreativeFormAudio                      CreativeForm = "audio"                          // аудиозапись.
+	CreativeFormVideo                      CreativeForm = "video"                          // видеоролик.
+	CreativeFormLiveAudio                  CreativeForm = "live_audio"                     // аудиотрансляция в прямом эфире.
+	CreativeFormLiveVideo                  CreativeForm = "live_video"                     // видеотрансляция в прямом эфире.
+	CreativeFormTextVideoBlock             CreativeForm = "text_video_block"               // текстовый блок с видео
+	CreativeFormTextGraphicBlock           CreativeForm = "text_graphic_block"             // текстово-графический блок
+	CreativeFormTextAudioBlock             CreativeForm = "text_audio_block"               // текстовый блок с аудио
+	CreativeFormTextGraphicVideoBlock      CreativeForm = "text_graphic_video_block"       // текстово-графический блок с видео
+	CreativeFormTextAudioVideoBlock        CreativeForm = "text_audio_video_block"         // текстовый блок с аудио и видео
+	CreativeFormTextGraphicAudioBlock      CreativeForm = "text_graphic_audio_block"       // текстово-графический блок с видео
+	CreativeFormTextGraphicAudioVideoBlock CreativeForm = "text_graphic_audio_video_block" // текстово-графический блок с аудио и видео
+	CreativeFormBannerHTML5                CreativeForm = "banner_html5"                   // HTML5-баннер
 )
 
+// AllCreativeForms returns all known creative forms
+func AllCreativeForms() []CreativeForm {
+	return []CreativeForm{
+		CreativeFormBanner,
+		CreativeFormText,
+		CreativeFormAudio,
+		CreativeFormVideo,
+		CreativeFormLiveAudio,
+		CreativeFormLiveVideo,
+		CreativeFormTextVideoBlock,
+		CreativeFormTextGraphicBlock,
+		CreativeFormTextAudioBlock,
+		CreativeFormTextGraphicVideoBlock,
+		CreativeFormTextAudioVideoBlock,
+		CreativeFormTextGraphicAudioBlock,
+		CreativeFormTextGraphicAudioVideoBlock,
+		CreativeFormBannerHTML5,
+	}
+}
+
+// ParseCreativeForm returns an error for unknown creative forms
+func ParseCreativeForm(s string) (CreativeForm, error) {
+	return parseEnum("creative form", s, AllCreativeForms())
+}
+
+func (f CreativeForm) Valid() bool {
+	return slices.Contains(AllCreativeForms(), f)
+}
+
+func (f CreativeForm) String() string {
+	return string(f)
+}
+
+// CreativeFlag is an additional property of a creative
+type CreativeFlag string
+
 const (
-	CreativeFlagSocial      = "social"       // социальная реклама.
-	CreativeFlagNative      = "native"       // нативная реклама (только в GET, PUT не поддерживается).
-	CreativeFlagSocialQuota = "social_quota" // социальная реклама по квоте.
+	CreativeFlagSocial      CreativeFlag = "social"       // социальная реклама.
+	CreativeFlagNative      CreativeFlag = "native"       // нативная реклама (только в GET, PUT не поддерживается).
+	CreativeFlagSocialQuota CreativeFlag = "social_quota" // социальная реклама по квоте.
 )
 
+// AllCreativeFlags returns all known creative flags
+func AllCreativeFlags() []CreativeFlag {
+	return []CreativeFlag{CreativeFlagSocial, CreativeFlagNative, CreativeFlagSocialQuota}
+}
+
+// ParseCreativeFlag returns an error for unknown creative flags
+func ParseCreativeFlag(s string) (CreativeFlag, error) {
+	return parseEnum("creative flag", s, AllCreativeFlags())
+}
+
+func (f CreativeFlag) Valid() bool {
+	return slices.Contains(AllCreativeFlags(), f)
+}
+
+func (f CreativeFlag) String() string {
+	return string(f)
+}
+
 type Creative struct {
-	CreateDate          string    `json:"create_date,omitempty"`
-	ERID                string    `json:"erid"`
-	PersonExternalID    *string   `json:"person_external_id,omitempty"`
-	ContractExternalID  *string   `json:"contract_external_id,omitempty"`
-	ContractExternalIDs *[]string `json:"contract_external_ids,omitempty"`
-	CIDs                *[]string `json:"cids,omitempty"`
-	OKVEDs              *[]string `json:"okveds,omitempty"`
-	KKTUs               []string  `json:"kktus"`
-	Name                *string   `json:"name,omitempty"`
-	Brand               *string   `json:"brand,omitempty"`
-	Category            *string   `json:"category,omitempty"`
-	Description         *string   `json:"description,omitempty"`
-	PayType             *string   `json:"pay_type,omitempty"`
-	Form                string    `json:"form"`
-	Targeting           *string   `json:"targeting,omitempty"`
-	TargetURLs          *[]string `json:"target_urls,omitempty"`
-	Texts               *[]string `json:"texts,omitempty"`
-	MediaExternalIDs    *[]string `json:"media_external_ids,omitempty"`
-	MediaURLs           *[]string `json:"media_urls,omitempty"`
-	Flags               *[]string `json:"flags,omitempty"`
+	CreateDate          string           `json:"create_date,omitempty"`
+	ERID                string           `json:"erid"`
+	PersonExternalID    *string          `json:"person_external_id,omitempty"`
+	ContractExternalID  *string          `json:"contract_external_id,omitempty"`
+	ContractExternalIDs *[]string        `json:"contract_external_ids,omitempty"`
+	CIDs                *[]string        `json:"cids,omitempty"`
+	OKVEDs              *[]string        `json:"okveds,omitempty"`
+	KKTUs               []string         `json:"kktus"`
+	Name                *string          `json:"name,omitempty"`
+	Brand               *string          `json:"brand,omitempty"`
+	Category            *string          `json:"category,omitempty"`
+	Description         *string          `json:"description,omitempty"`
+	PayType             *CreativePayType `json:"pay_type,omitempty"`
+	Form                CreativeForm     `json:"form"`
+	Targeting           *string          `json:"targeting,omitempty"`
+	TargetURLs          *[]string        `json:"target_urls,omitempty"`
+	Texts               *[]string        `json:"texts,omitempty"`
+	MediaExternalIDs    *[]string        `json:"media_external_ids,omitempty"`
+	MediaURLs           *[]string        `json:"media_urls,omitempty"`
+	Flags               *[]CreativeFlag  `json:"flags,omitempty"`
 }
 
 // CreativeListResponse represents the response for getting a list of creatives
@@ -98,42 +177,129 @@ type AddMediaToCreativeRequest struct {
 
 // CreateCreativeV2Request represents the request body for creating/updating a creative (v2)
 type CreateCreativeV2Request struct {
-	PersonExternalID   *string   `json:"person_external_id,omitempty"`
-	ContractExternalID *string   `json:"contract_external_id,omitempty"`
-	OKVEDs             *[]string `json:"okveds,omitempty"`
-	KKTUs              []string  `json:"kktus"`
-	Name               *string   `json:"name,omitempty"`
-	Brand              *string   `json:"brand,omitempty"`
-	Category           *string   `json:"category,omitempty"`
-	Description        *string   `json:"description,omitempty"`
-	PayType            *string   `json:"pay_type,omitempty"`
-	Form               string    `json:"form"`
-	Targeting          *string   `json:"targeting,omitempty"`
-	TargetURLs         *[]string `json:"target_urls,omitempty"`
-	Texts              *[]string `json:"texts,omitempty"`
-	MediaExternalIDs   *[]string `json:"media_external_ids,omitempty"`
-	MediaURLs          *[]string `json:"media_urls,omitempty"`
-	Flags              *[]string `json:"flags,omitempty"`
+	PersonExternalID   *string          `json:"person_external_id,omitempty"`
+	ContractExternalID *string          `json:"contract_external_id,omitempty"`
+	OKVEDs             *[]string        `json:"okveds,omitempty"`
+	KKTUs              []string         `json:"kktus"`
+	Name               *string          `json:"name,omitempty"`
+	Brand              *string          `json:"brand,omitempty"`
+	Category           *string          `json:"category,omitempty"`
+	Description        *string          `json:"description,omitempty"`
+	PayType            *CreativePayType `json:"pay_type,omitempty"`
+	Form               CreativeForm     `json:"form"`
+	Targeting          *string          `json:"targeting,omitempty"`
+	TargetURLs         *[]string        `json:"target_urls,omitempty"`
+	Texts              *[]string        `json:"texts,omitempty"`
+	MediaExternalIDs   *[]string        `json:"media_external_ids,omitempty"`
+	MediaURLs          *[]string        `json:"media_urls,omitempty"`
+	Flags              *[]CreativeFlag  `json:"flags,omitempty"`
 }
 
 // CreateCreativeV3Request represents the request body for creating/updating a creative (v3)
 type CreateCreativeV3Request struct {
-	PersonExternalID    *string   `json:"person_external_id,omitempty"`
-	ContractExternalIDs *[]string `json:"contract_external_ids,omitempty"`
-	CIDs                *[]string `json:"cids,omitempty"`
-	KKTUs               []string  `json:"kktus"`
-	Name                *string   `json:"name,omitempty"`
-	Brand               *string   `json:"brand,omitempty"`
-	Category            *string   `json:"category,omitempty"`
-	Description         *string   `json:"description,omitempty"`
-	PayType             *string   `json:"pay_type,omitempty"`
-	Form                string    `json:"form"`
-	Targeting           *string   `json:"targeting,omitempty"`
-	TargetURLs          *[]string `json:"target_urls,omitempty"`
-	Texts               *[]string `json:"texts,omitempty"`
-	MediaExternalIDs    *[]string `json:"media_external_ids,omitempty"`
-	MediaURLs           *[]string `json:"media_urls,omitempty"`
-	Flags               *[]string `json:"flags,omitempty"`
+	PersonExternalID    *string          `json:"person_external_id,omitempty"`
+	ContractExternalIDs *[]string        `json:"contract_external_ids,omitempty"`
+	CIDs                *[]string        `json:"cids,omitempty"`
+	KKTUs               []string         `json:"kktus"`
+	Name                *string          `json:"name,omitempty"`
+	Brand               *string          `json:"brand,omitempty"`
+	Category            *string          `json:"category,omitempty"`
+	Description         *string          `json:"description,omitempty"`
+	PayType             *CreativePayType `json:"pay_type,omitempty"`
+	Form                CreativeForm     `json:"form"`
+	Targeting           *string          `json:"targeting,omitempty"`
+	TargetURLs          *[]string        `json:"target_urls,omitempty"`
+	Texts               *[]string        `json:"texts,omitempty"`
+	MediaExternalIDs    *[]string        `json:"media_external_ids,omitempty"`
+	MediaURLs           *[]string        `json:"media_urls,omitempty"`
+	Flags               *[]CreativeFlag  `json:"flags,omitempty"`
+}
+
+// Validate checks form, KKTUs, texts and media required by the form, pay type, flags
+// and that person_external_id is not combined with contract_external_id
+func (r CreateCreativeV2Request) Validate() error {
+	v := &validator{}
+	validateCreative(v, r.Form, r.KKTUs, r.PayType, r.Texts, r.MediaExternalIDs, r.MediaURLs, r.Flags)
+
+	if r.PersonExternalID != nil && *r.PersonExternalID != "" && r.ContractExternalID != nil && *r.ContractExternalID != "" {
+		v.add("person_external_id", "can't be combined with contract_external_id")
+	}
+
+	return v.err()
+}
+
+// Validate checks the same rules as CreateCreativeV2Request.Validate and requires exactly one of
+// contract_external_ids, cids and person_external_id (self-promotion), social advertising may have none
+func (r CreateCreativeV3Request) Validate() error {
+	v := &validator{}
+	validateCreative(v, r.Form, r.KKTUs, r.PayType, r.Texts, r.MediaExternalIDs, r.MediaURLs, r.Flags)
+
+	social := r.Flags != nil && (slices.Contains(*r.Flags, CreativeFlagSocial) || slices.Contains(*r.Flags, CreativeFlagSocialQuota))
+	selfPromotion := r.PersonExternalID != nil && *r.PersonExternalID != ""
+	contracts, cids := notEmpty(r.ContractExternalIDs), notEmpty(r.CIDs)
+	if !contracts && !cids && !social && !selfPromotion {
+		v.add("contract_external_ids", "contract_external_ids or cids is required unless the creative is social or self-promotion")
+	}
+
+	if selfPromotion && contracts {
+		v.add("person_external_id", "can't be combined with contract_external_ids")
+	}
+	if selfPromotion && cids {
+		v.add("person_external_id", "can't be combined with cids")
+	}
+	if contracts && cids {
+		v.add("contract_external_ids", "can't be combined with cids")
+	}
+
+	return v.err()
+}
+
+func validateCreative(v *validator, form CreativeForm, kktus []string, payType *CreativePayType, texts, mediaExternalIDs, mediaURLs *[]string, flags *[]CreativeFlag) {
+	var needTexts, needMedia bool
+	switch form {
+	case CreativeFormText:
+		needTexts = true
+	case CreativeFormBanner, CreativeFormAudio, CreativeFormVideo, CreativeFormBannerHTML5:
+		needMedia = true
+	case CreativeFormTextVideoBlock, CreativeFormTextGraphicBlock, CreativeFormTextAudioBlock, CreativeFormTextGraphicVideoBlock,
+		CreativeFormTextAudioVideoBlock, CreativeFormTextGraphicAudioBlock, CreativeFormTextGraphicAudioVideoBlock:
+		needTexts, needMedia = true, true
+	case CreativeFormLiveAudio, CreativeFormLiveVideo:
+	case "":
+		v.add("form", "is required")
+	default:
+		v.add("form", "unknown form %q", form)
+	}
+
+	if len(kktus) == 0 {
+		v.add("kktus", "at least one KKTU is required")
+	}
+
+	if needTexts && !notEmpty(texts) {
+		v.add("texts", "are required for form %s", form)
+	}
+	if needMedia && !notEmpty(mediaExternalIDs) && !notEmpty(mediaURLs) {
+		v.add("media_external_ids", "media_external_ids or media_urls is required for form %s", form)
+	}
+
+	if payType != nil && !payType.Valid() {
+		v.add("pay_type", "unknown pay type %q", *payType)
+	}
+
+	if flags != nil {
+		for _, flag := range *flags {
+			switch {
+			case !flag.Valid():
+				v.add("flags", "unknown flag %q", flag)
+			case flag == CreativeFlagNative:
+				v.add("flags", "%s is read-only and can't be set on PUT", flag)
+			}
+		}
+	}
+}
+
+func notEmpty(values *[]string) bool {
+	return values != nil && len(*values) > 0
 }
 
 // GetCreatives retrieves a list of creatives
@@ -142,7 +308,7 @@ func (c *Client) GetCreatives(ctx context.Context, offset, limit int) (*Creative
 	path := fmt.Sprintf("/v3/creative?offset=%d&limit=%d", offset, limit)
 
 	var response CreativeListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetCreatives", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get creatives: %w", err)
 	}
 
@@ -155,7 +321,7 @@ func (c *Client) GetCreativeERIDs(ctx context.Context, offset, limit int) (*Crea
 	path := fmt.Sprintf("/v3/creative/list/erids?offset=%d&limit=%d", offset, limit)
 
 	var response CreativeERIDsListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetCreativeERIDs", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get creative ERIDs: %w", err)
 	}
 
@@ -168,7 +334,7 @@ func (c *Client) GetCreativeERIDExternalIDPairs(ctx context.Context, offset, lim
 	path := fmt.Sprintf("/v3/creative/list/erid_external_ids?offset=%d&limit=%d", offset, limit)
 
 	var response CreativeERIDExternalIDPairsResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetCreativeERIDExternalIDPairs", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get creative ERID/external ID pairs: %w", err)
 	}
 
@@ -177,14 +343,19 @@ func (c *Client) GetCreativeERIDExternalIDPairs(ctx context.Context, offset, lim
 
 // CreateCreativeV2 creates or updates a creative (v2)
 // PUT /v2/creative/{external_id}
-func (c *Client) CreateCreativeV2(ctx context.Context, externalID string, creative CreateCreativeV2Request) error {
+func (c *Client) CreateCreativeV2(ctx context.Context, externalID string, creative CreateCreativeV2Request) (*PutResult, error) {
 	path := fmt.Sprintf("/v2/creative/%s", externalID)
 
-	if err := c.request(ctx, "PUT", path, creative, nil); err != nil {
-		return fmt.Errorf("failed to create creative (v2): %w", err)
+	var result PutResult
+	if err := c.request(ctx, "CreateCreativeV2", externalID, "PUT", path, creative, &result); err != nil {
+		return nil, fmt.Errorf("failed to create creative (v2): %w", err)
 	}
 
-	return nil
+	if err := c.checkInfoMessages(&result); err != nil {
+		return &result, fmt.Errorf("failed to create creative (v2): %w", err)
+	}
+
+	return &result, nil
 }
 
 // GetCreativeV2 retrieves a creative by external ID (v2)
@@ -193,7 +364,7 @@ func (c *Client) GetCreativeV2(ctx context.Context, externalID string) (*Creativ
 	path := fmt.Sprintf("/v2/creative/%s", externalID)
 
 	var creative Creative
-	if err := c.request(ctx, "GET", path, nil, &creative); err != nil {
+	if err := c.request(ctx, "GetCreativeV2", externalID, "GET", path, nil, &creative); err != nil {
 		return nil, fmt.Errorf("failed to get creative (v2): %w", err)
 	}
 
@@ -206,7 +377,7 @@ func (c *Client) GetCreativeByERIDV2(ctx context.Context, erid string) (*Creativ
 	path := fmt.Sprintf("/v2/creative/by_erid/%s", url.PathEscape(erid))
 
 	var creative Creative
-	if err := c.request(ctx, "GET", path, nil, &creative); err != nil {
+	if err := c.request(ctx, "GetCreativeByERIDV2", "", "GET", path, nil, &creative); err != nil {
 		return nil, fmt.Errorf("failed to get creative by ERID (v2): %w", err)
 	}
 
@@ -215,14 +386,19 @@ func (c *Client) GetCreativeByERIDV2(ctx context.Context, erid string) (*Creativ
 
 // CreateCreativeV3 creates or updates a creative (v3)
 // PUT /v3/creative/{external_id}
-func (c *Client) CreateCreativeV3(ctx context.Context, externalID string, creative CreateCreativeV3Request) error {
+func (c *Client) CreateCreativeV3(ctx context.Context, externalID string, creative CreateCreativeV3Request) (*PutResult, error) {
 	path := fmt.Sprintf("/v3/creative/%s", externalID)
 
-	if err := c.request(ctx, "PUT", path, creative, nil); err != nil {
-		return fmt.Errorf("failed to create creative (v3): %w", err)
+	var result PutResult
+	if err := c.request(ctx, "CreateCreativeV3", externalID, "PUT", path, creative, &result); err != nil {
+		return nil, fmt.Errorf("failed to create creative (v3): %w", err)
 	}
 
-	return nil
+	if err := c.checkInfoMessages(&result); err != nil {
+		return &result, fmt.Errorf("failed to create creative (v3): %w", err)
+	}
+
+	return &result, nil
 }
 
 // GetCreativeV3 retrieves a creative by external ID (v3)
@@ -231,7 +407,7 @@ func (c *Client) GetCreativeV3(ctx context.Context, externalID string) (*Creativ
 	path := fmt.Sprintf("/v3/creative/%s", externalID)
 
 	var creative Creative
-	if err := c.request(ctx, "GET", path, nil, &creative); err != nil {
+	if err := c.request(ctx, "GetCreativeV3", externalID, "GET", path, nil, &creative); err != nil {
 		return nil, fmt.Errorf("failed to get creative (v3): %w", err)
 	}
 
@@ -244,7 +420,7 @@ func (c *Client) GetCreativeByERIDV3(ctx context.Context, erid string) (*Creativ
 	path := fmt.Sprintf("/v3/creative/by_erid/%s", url.PathEscape(erid))
 
 	var creative Creative
-	if err := c.request(ctx, "GET", path, nil, &creative); err != nil {
+	if err := c.request(ctx, "GetCreativeByERIDV3", "", "GET", path, nil, &creative); err != nil {
 		return nil, fmt.Errorf("failed to get creative by ERID (v3): %w", err)
 	}
 
@@ -260,7 +436,7 @@ func (c *Client) AddTextsToCreative(ctx context.Context, externalID string, text
 		Texts: texts,
 	}
 
-	if err := c.request(ctx, "POST", path, request, nil); err != nil {
+	if err := c.request(ctx, "AddTextsToCreative", externalID, "POST", path, request, nil); err != nil {
 		return fmt.Errorf("failed to add texts to creative: %w", err)
 	}
 
@@ -276,7 +452,7 @@ func (c *Client) AddMediaToCreative(ctx context.Context, externalID string, medi
 		MediaExternalIDs: mediaExternalIDs,
 	}
 
-	if err := c.request(ctx, "POST", path, request, nil); err != nil {
+	if err := c.request(ctx, "AddMediaToCreative", externalID, "POST", path, request, nil); err != nil {
 		return fmt.Errorf("failed to add media to creative: %w", err)
 	}
 
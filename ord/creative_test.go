@@ -84,7 +84,7 @@ func TestClient_CreativeMethods(t *testing.T) {
 			Form:  "video",
 			KKTUs: []string{"12345"},
 		}
-		err := client.CreateCreativeV2(context.Background(), "test-external-id", creative)
+		_, err := client.CreateCreativeV2(context.Background(), "test-external-id", creative)
 		require.NoError(t, err)
 	})
 
@@ -108,7 +108,7 @@ func TestClient_CreativeMethods(t *testing.T) {
 			Form:  "video",
 			KKTUs: []string{"12345"},
 		}
-		err := client.CreateCreativeV3(context.Background(), "test-external-id", creative)
+		_, err := client.CreateCreativeV3(context.Background(), "test-external-id", creative)
 		require.NoError(t, err)
 	})
 
@@ -256,3 +256,76 @@ func handleAddMediaToCreative(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusOK)
 }
+
+func TestCreateCreativeV2Request_Validate(t *testing.T) {
+	valid := CreateCreativeV2Request{
+		KKTUs:            []string{"30.10.1"},
+		Form:             CreativeFormTextGraphicBlock,
+		PayType:          Ptr(CreativePayTypeCPM),
+		Texts:            &[]string{"text"},
+		MediaExternalIDs: &[]string{"media1"},
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := CreateCreativeV2Request{
+		Form:    CreativeFormTextGraphicBlock,
+		PayType: Ptr[CreativePayType]("cpv"),
+		Flags:   &[]CreativeFlag{CreativeFlagNative},
+	}
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, invalid.Validate(), &verrs)
+	for _, field := range []string{"kktus", "texts", "media_external_ids", "pay_type", "flags"} {
+		assert.True(t, verrs.Has(field), field)
+	}
+
+	invalid = CreateCreativeV2Request{KKTUs: []string{"30.10.1"}, Form: "poster"}
+	require.ErrorAs(t, invalid.Validate(), &verrs)
+	assert.True(t, verrs.Has("form"))
+
+	live := CreateCreativeV2Request{KKTUs: []string{"30.10.1"}, Form: CreativeFormLiveVideo}
+	assert.NoError(t, live.Validate())
+
+	both := live
+	both.PersonExternalID = Ptr("person1")
+	both.ContractExternalID = Ptr("contract1")
+	require.ErrorAs(t, both.Validate(), &verrs)
+	assert.True(t, verrs.Has("person_external_id"))
+}
+
+func TestCreateCreativeV3Request_Validate(t *testing.T) {
+	request := CreateCreativeV3Request{
+		KKTUs:     []string{"30.10.1"},
+		Form:      CreativeFormBanner,
+		MediaURLs: &[]string{"https://example.com/banner.png"},
+	}
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, request.Validate(), &verrs)
+	assert.True(t, verrs.Has("contract_external_ids"))
+
+	withCIDs := request
+	withCIDs.CIDs = &[]string{"cid1"}
+	assert.NoError(t, withCIDs.Validate())
+
+	social := request
+	social.Flags = &[]CreativeFlag{CreativeFlagSocial}
+	assert.NoError(t, social.Validate())
+
+	selfPromotion := request
+	selfPromotion.PersonExternalID = Ptr("person1")
+	assert.NoError(t, selfPromotion.Validate())
+
+	all := selfPromotion
+	all.ContractExternalIDs = &[]string{"contract1"}
+	all.CIDs = &[]string{"cid1"}
+	require.ErrorAs(t, all.Validate(), &verrs)
+	assert.Len(t, verrs, 3)
+	assert.True(t, verrs.Has("person_external_id"))
+	assert.True(t, verrs.Has("contract_external_ids"))
+
+	contractsAndCIDs := withCIDs
+	contractsAndCIDs.ContractExternalIDs = &[]string{"contract1"}
+	require.ErrorAs(t, contractsAndCIDs.Validate(), &verrs)
+	assert.Equal(t, ValidationErrors{{Field: "contract_external_ids", Message: "can't be combined with cids"}}, verrs)
+}
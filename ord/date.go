@@ -0,0 +1,170 @@
+package ord
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date is a calendar date without time and timezone, the API sends and accepts it as YYYY-MM-DD
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns a date, overflowing values are normalized like in time.Date
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the date of t in the location of t
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a date in YYYY-MM-DD format, timestamps like RFC3339 are rejected
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD: %w", s, err)
+	}
+
+	return DateOf(t), nil
+}
+
+// String formats the date as YYYY-MM-DD
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// Time returns midnight of the date in loc
+func (d Date) Time(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// IsZero reports whether the date is not set
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Before reports whether d is before o
+func (d Date) Before(o Date) bool {
+	return d.Time(time.UTC).Before(o.Time(time.UTC))
+}
+
+// After reports whether d is after o
+func (d Date) After(o Date) bool {
+	return d.Time(time.UTC).After(o.Time(time.UTC))
+}
+
+// AddDays returns the date n days after d
+func (d Date) AddDays(n int) Date {
+	return NewDate(d.Year, d.Month, d.Day+n)
+}
+
+// FirstDayOfMonth returns the first day of the month of d
+func (d Date) FirstDayOfMonth() Date {
+	return Date{Year: d.Year, Month: d.Month, Day: 1}
+}
+
+// LastDayOfMonth returns the last day of the month of d
+func (d Date) LastDayOfMonth() Date {
+	return NewDate(d.Year, d.Month+1, 0)
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+	parsed, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// DatePtr returns a pointer to the date, it is handy for optional fields
+func DatePtr(d Date) *Date {
+	return &d
+}
+
+func dateString(d *Date) *string {
+	if d == nil {
+		return nil
+	}
+
+	s := d.String()
+
+	return &s
+}
+
+// Month is a calendar month, the API sends and accepts it as the first day of the month YYYY-MM-01
+type Month struct {
+	Year  int
+	Month time.Month
+}
+
+// NewMonth returns a month, overflowing months are normalized like in time.Date
+func NewMonth(year int, month time.Month) Month {
+	return MonthOf(NewDate(year, month, 1))
+}
+
+// MonthOf returns the month of the date
+func MonthOf(d Date) Month {
+	return Month{Year: d.Year, Month: d.Month}
+}
+
+// ParseMonth parses a month in YYYY-MM-01 or YYYY-MM format
+func ParseMonth(s string) (Month, error) {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		d, dateErr := ParseDate(s)
+		if dateErr != nil || d.Day != 1 {
+			return Month{}, fmt.Errorf("invalid month %q, expected YYYY-MM-01", s)
+		}
+
+		return MonthOf(d), nil
+	}
+
+	return MonthOf(DateOf(t)), nil
+}
+
+// String formats the month as YYYY-MM-01
+func (m Month) String() string {
+	return m.FirstDay().String()
+}
+
+// FirstDay returns the first day of the month
+func (m Month) FirstDay() Date {
+	return Date{Year: m.Year, Month: m.Month, Day: 1}
+}
+
+// LastDay returns the last day of the month
+func (m Month) LastDay() Date {
+	return m.FirstDay().LastDayOfMonth()
+}
+
+// AddMonths returns the month n months after m
+func (m Month) AddMonths(n int) Month {
+	return NewMonth(m.Year, m.Month+time.Month(n))
+}
+
+func (m Month) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Month) UnmarshalText(data []byte) error {
+	parsed, err := ParseMonth(string(data))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
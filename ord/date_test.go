@@ -0,0 +1,107 @@
+package ord
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate("2024-02-29")
+	require.NoError(t, err)
+	assert.Equal(t, Date{Year: 2024, Month: time.February, Day: 29}, d)
+	assert.Equal(t, "2024-02-29", d.String())
+
+	for _, s := range []string{"2024-02-30", "2024-02-29T00:00:00Z", "29.02.2024", ""} {
+		_, err := ParseDate(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestDate_Month(t *testing.T) {
+	d := NewDate(2024, time.February, 10)
+	assert.Equal(t, "2024-02-01", d.FirstDayOfMonth().String())
+	assert.Equal(t, "2024-02-29", d.LastDayOfMonth().String())
+	assert.Equal(t, "2023-12-31", NewDate(2023, time.December, 1).LastDayOfMonth().String())
+	assert.Equal(t, "2024-03-01", d.LastDayOfMonth().AddDays(1).String())
+
+	assert.True(t, d.Before(d.AddDays(1)))
+	assert.True(t, d.After(d.FirstDayOfMonth()))
+	assert.True(t, Date{}.IsZero())
+}
+
+func TestDateOf(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	ts := time.Date(2024, time.January, 1, 1, 0, 0, 0, moscow)
+
+	assert.Equal(t, "2024-01-01", DateOf(ts).String(), "date is taken in the location of the time")
+	assert.Equal(t, "2023-12-31", DateOf(ts.UTC()).String())
+	assert.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, moscow), DateOf(ts).Time(moscow))
+}
+
+func TestDate_JSON(t *testing.T) {
+	var v struct {
+		Date    Date  `json:"date"`
+		DateEnd *Date `json:"date_end,omitempty"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"date": "2024-05-01"}`), &v))
+	assert.Equal(t, NewDate(2024, time.May, 1), v.Date)
+	assert.Nil(t, v.DateEnd)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"date": "2024-05-01T10:00:00+03:00"}`), &v))
+
+	v.DateEnd = DatePtr(NewDate(2024, time.May, 31))
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"date": "2024-05-01", "date_end": "2024-05-31"}`, string(data))
+}
+
+func TestContractRequest_Request(t *testing.T) {
+	request := ContractRequest{
+		Type:                 ContractTypeService,
+		ClientExternalID:     "client1",
+		ContractorExternalID: "contractor1",
+		SubjectType:          ContractSubjectTypeDistribution,
+		Date:                 NewDate(2024, time.January, 1),
+		DateEnd:              DatePtr(NewDate(2024, time.December, 31)),
+	}.Request()
+
+	assert.Equal(t, "2024-01-01", request.Date)
+	assert.Equal(t, "2024-12-31", *request.DateEnd)
+	assert.NoError(t, request.Validate())
+}
+
+func TestStatisticsItem_Item(t *testing.T) {
+	month := NewDate(2024, time.March, 15)
+
+	item := StatisticsItem{
+		CreativeExternalID: "creative1",
+		PadExternalID:      "pad1",
+		DateStartActual:    month.FirstDayOfMonth(),
+		DateEndActual:      month.LastDayOfMonth(),
+	}.Item()
+
+	assert.Equal(t, "2024-03-01", item.DateStartActual)
+	assert.Equal(t, "2024-03-31", item.DateEndActual)
+	assert.Nil(t, item.DateStartPlanned)
+}
+
+func TestMonth(t *testing.T) {
+	m, err := ParseMonth("2024-02-01")
+	require.NoError(t, err)
+	assert.Equal(t, NewMonth(2024, time.February), m)
+	assert.Equal(t, "2024-02-01", m.String())
+	assert.Equal(t, "2024-02-29", m.LastDay().String())
+	assert.Equal(t, NewMonth(2025, time.January), m.AddMonths(11))
+
+	m, err = ParseMonth("2024-03")
+	require.NoError(t, err)
+	assert.Equal(t, NewMonth(2024, time.March), m)
+
+	_, err = ParseMonth("2024-02-15")
+	assert.Error(t, err)
+}
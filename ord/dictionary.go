@@ -51,7 +51,7 @@ func (s *Client) GetKKTUCodes(ctx context.Context, search, lang string, offset,
 	}
 
 	var response KKTUResponse
-	err := s.request(ctx, http.MethodGet, path, nil, &response)
+	err := s.request(ctx, "GetKKTUCodes", "", http.MethodGet, path, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +74,7 @@ func (s *Client) GetERIRMessage(ctx context.Context, lang, message string) (*ERI
 	}
 
 	var response ERIRMessageResponse
-	err := s.request(ctx, http.MethodGet, path, nil, &response)
+	err := s.request(ctx, "GetERIRMessage", "", http.MethodGet, path, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +94,7 @@ func (s *Client) PostERIRMessages(ctx context.Context, lang string, messages []s
 	}
 
 	var response ERIRMessageResponse
-	err := s.request(ctx, http.MethodPost, "/v1/dict/erir_message", req, &response)
+	err := s.request(ctx, "PostERIRMessages", "", http.MethodPost, "/v1/dict/erir_message", req, &response)
 	if err != nil {
 		return nil, err
 	}
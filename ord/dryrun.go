@@ -0,0 +1,125 @@
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// DryRunRecord is a mutating call which would have been sent to the API
+type DryRunRecord struct {
+	Operation   string          `json:"operation"`
+	Method      string          `json:"method"`
+	Path        string          `json:"path"`
+	ExternalID  string          `json:"external_id,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	ContentType string          `json:"content_type,omitempty"` // только для медиафайлов.
+	Size        int             `json:"size,omitempty"`         // размер тела медиафайла.
+}
+
+// Recorder stores mutating calls in dry-run mode
+type Recorder interface {
+	Record(ctx context.Context, record DryRunRecord) error
+}
+
+// MemoryRecorder keeps records in memory
+type MemoryRecorder struct {
+	mu      sync.Mutex
+	records []DryRunRecord
+}
+
+func (r *MemoryRecorder) Record(ctx context.Context, record DryRunRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records = append(r.records, record)
+
+	return nil
+}
+
+// Records returns a copy of the recorded calls
+func (r *MemoryRecorder) Records() []DryRunRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]DryRunRecord(nil), r.records...)
+}
+
+// JSONLRecorder writes each record as a JSON line
+type JSONLRecorder struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+func NewJSONLRecorder(w io.Writer) *JSONLRecorder {
+	return &JSONLRecorder{w: w}
+}
+
+// NewJSONLFileRecorder appends records to the file, the file is created if it does not exist
+func NewJSONLFileRecorder(path string) (*JSONLRecorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dry-run file: %w", err)
+	}
+
+	return &JSONLRecorder{w: f, closer: f}, nil
+}
+
+func (r *JSONLRecorder) Record(ctx context.Context, record DryRunRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dry-run record: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write dry-run record: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the file opened by NewJSONLFileRecorder
+func (r *JSONLRecorder) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+
+	return r.closer.Close()
+}
+
+// dryRun validates and records the mutating call instead of sending it
+func (c *Client) dryRun(ctx context.Context, call *Call) error {
+	record := DryRunRecord{
+		Operation:  call.Operation,
+		Method:     call.Method,
+		Path:       call.Path,
+		ExternalID: call.ExternalID,
+	}
+
+	switch body := call.Body.(type) {
+	case nil:
+	case []byte:
+		record.ContentType = call.ContentType
+		record.Size = len(body)
+	default:
+		if err := validateBody(body); err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+
+		record.Body = data
+	}
+
+	return c.recorder.Record(ctx, record)
+}
@@ -0,0 +1,138 @@
+//nolint:errcheck
+package ord
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	var methods []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		json.NewEncoder(w).Encode(Person{Name: "test"})
+	}))
+	defer server.Close()
+
+	recorder := &MemoryRecorder{}
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithDryRun(recorder),
+	)
+
+	person := Person{
+		Name:             "test",
+		Roles:            []PersonRole{PersonRoleAdvertiser},
+		JuridicalDetails: JuridicalDetails{Type: PersonTypeJuridical, INN: "7707083893"},
+	}
+	_, err := client.CreatePerson(context.Background(), "person1", person)
+	require.NoError(t, err)
+	require.NoError(t, client.DeleteInvoice(context.Background(), "invoice1"))
+	require.NoError(t, client.AddContractsToInvoice(context.Background(), "invoice1", []InvoiceItem{}))
+
+	_, err = client.UploadMedia(context.Background(), "media1", "test.txt", strings.NewReader("data"))
+	require.NoError(t, err)
+
+	result, err := client.GetPerson(context.Background(), "person1")
+	require.NoError(t, err)
+	assert.Equal(t, "test", result.Name)
+
+	_, err = client.GetMediaInfoBatch(context.Background(), []string{"media1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"GET /v1/person/person1", "POST /v1/get_media_info"}, methods)
+
+	records := recorder.Records()
+	require.Len(t, records, 4)
+
+	assert.Equal(t, "CreatePerson", records[0].Operation)
+	assert.Equal(t, "PUT", records[0].Method)
+	assert.Equal(t, "/v1/person/person1", records[0].Path)
+	assert.Equal(t, "person1", records[0].ExternalID)
+	assert.JSONEq(t, `{"name":"test","roles":["advertiser"],"juridical_details":{"type":"juridical","inn":"7707083893"}}`, string(records[0].Body))
+
+	assert.Equal(t, "DeleteInvoice", records[1].Operation)
+	assert.Equal(t, "DELETE", records[1].Method)
+	assert.Nil(t, records[1].Body)
+
+	assert.Equal(t, "PATCH", records[2].Method)
+	assert.JSONEq(t, `{"items":[]}`, string(records[2].Body))
+
+	assert.Equal(t, "UploadMedia", records[3].Operation)
+	assert.Contains(t, records[3].ContentType, "multipart/form-data")
+	assert.Greater(t, records[3].Size, 0)
+}
+
+func TestClient_DryRun_ProductionGuard(t *testing.T) {
+	recorder := &MemoryRecorder{}
+
+	client, _ := NewClient(
+		WithEnvironment(EnvProduction),
+		WithProductionGuard(),
+		WithDryRun(recorder),
+	)
+
+	require.NoError(t, client.RequestCID(context.Background(), "contract1"))
+	assert.Len(t, recorder.Records(), 1)
+}
+
+func TestJSONLFileRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dry-run.jsonl")
+
+	recorder, err := NewJSONLFileRecorder(path)
+	require.NoError(t, err)
+
+	client, _ := NewClient(WithDryRun(recorder))
+
+	_, err = client.CreatePad(context.Background(), "pad1", Pad{Name: "pad"})
+	require.NoError(t, err)
+	require.NoError(t, client.SendInvoiceToErir(context.Background(), "invoice1"))
+	require.NoError(t, recorder.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []DryRunRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record DryRunRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 2)
+	assert.Equal(t, "CreatePad", records[0].Operation)
+	assert.Equal(t, "/v1/pad/pad1", records[0].Path)
+	assert.Equal(t, "SendInvoiceToErir", records[1].Operation)
+	assert.Equal(t, "POST", records[1].Method)
+}
+
+func TestClient_DryRun_ValidatesPerson(t *testing.T) {
+	recorder := &MemoryRecorder{}
+
+	client, _ := NewClient(
+		WithToken("test-token"),
+		WithDryRun(recorder),
+	)
+
+	_, err := client.CreatePerson(context.Background(), "person1", Person{Name: "test", Roles: []PersonRole{PersonRoleAdvertiser}})
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+	assert.True(t, verrs.Has("juridical_details.type"))
+	assert.Empty(t, recorder.Records())
+}
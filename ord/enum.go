@@ -0,0 +1,15 @@
+package ord
+
+import (
+	"fmt"
+	"slices"
+)
+
+// parseEnum returns s converted to T if it is one of values
+func parseEnum[T ~string](name, s string, values []T) (T, error) {
+	if !slices.Contains(values, T(s)) {
+		return "", fmt.Errorf("invalid %s: %q", name, s)
+	}
+
+	return T(s), nil
+}
@@ -0,0 +1,53 @@
+package ord
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnums(t *testing.T) {
+	personType, err := ParsePersonType("juridical")
+	require.NoError(t, err)
+	assert.Equal(t, PersonTypeJuridical, personType)
+
+	_, err = ParsePersonType("company")
+	assert.EqualError(t, err, `invalid person type: "company"`)
+
+	status, err := ParseErirStatus("verified")
+	require.NoError(t, err)
+	assert.Equal(t, ErirStatusVerified, status)
+
+	_, err = ParseErirDataType("persons")
+	assert.Error(t, err)
+}
+
+func TestEnums_Valid(t *testing.T) {
+	for _, v := range AllContractFlags() {
+		assert.True(t, v.Valid(), v.String())
+	}
+	for _, v := range AllCreativeForms() {
+		assert.True(t, v.Valid(), v.String())
+	}
+	for _, v := range AllErirDataTypes() {
+		assert.True(t, v.Valid(), v.String())
+	}
+
+	assert.False(t, PadType("tv").Valid())
+	assert.False(t, CreativePayType("").Valid())
+	assert.Len(t, AllCreativeForms(), 14)
+	assert.Equal(t, StatisticsPayTypeOther, PStatisticsayTypeOther)
+}
+
+func TestEnums_JSON(t *testing.T) {
+	var person Person
+	require.NoError(t, json.Unmarshal([]byte(`{"roles": ["advertiser", "ors"], "juridical_details": {"type": "ip"}}`), &person))
+	assert.Equal(t, []PersonRole{PersonRoleAdvertiser, PersonRoleOrs}, person.Roles)
+	assert.Equal(t, PersonTypeIP, person.JuridicalDetails.Type)
+
+	data, err := json.Marshal(ErirStatusRequest{DataType: ErirDataTypeInvoice, ErirStatus: ErirStatusBad})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data_type": "invoice", "erir_status": "bad"}`, string(data))
+}
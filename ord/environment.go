@@ -0,0 +1,56 @@
+package ord
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Environment is the ORD VK API host from the swagger servers list
+type Environment string
+
+const (
+	EnvSandbox    Environment = "https://api-sandbox.ord.vk.com" // песочница.
+	EnvProduction Environment = "https://api.ord.vk.com"         // продакшн, данные попадают в ЕРИР.
+)
+
+// ErrProductionWrite is returned for mutating calls against production when the guard is enabled
+var ErrProductionWrite = errors.New("mutating requests to production are disabled")
+
+// readOnlyOperations are POST methods which do not change any data
+var readOnlyOperations = map[string]bool{
+	"GetMediaInfoBatch": true,
+	"PostErirStatuses":  true,
+	"PostERIRMessages":  true,
+}
+
+// Environment returns the environment the client works with, empty for custom hosts set via WithBase
+func (c *Client) Environment() Environment {
+	switch Environment(strings.TrimSuffix(c.base, "/")) {
+	case EnvSandbox:
+		return EnvSandbox
+	case EnvProduction:
+		return EnvProduction
+	}
+
+	return ""
+}
+
+// isMutating reports whether the call creates, changes or deletes data
+func isMutating(call *Call) bool {
+	if call.Method == http.MethodGet || call.Method == http.MethodHead {
+		return false
+	}
+
+	return !readOnlyOperations[call.Operation]
+}
+
+// guard rejects mutating calls against production unless writes are explicitly allowed,
+// calls in dry-run mode are never sent and are not guarded
+func (c *Client) guard(call *Call) error {
+	if !c.productionGuard || c.recorder != nil || c.productionWrites || c.Environment() != EnvProduction || !isMutating(call) {
+		return nil
+	}
+
+	return ErrProductionWrite
+}
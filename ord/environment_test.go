@@ -0,0 +1,117 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestClient_ProductionGuard(t *testing.T) {
+	var hosts []string
+
+	httpClient := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		hosts = append(hosts, r.URL.Host)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Header:     http.Header{},
+		}, nil
+	})}
+
+	t.Run("Production", func(t *testing.T) {
+		hosts = nil
+
+		client, err := NewClient(
+			WithEnvironment(EnvProduction),
+			WithHttpClient(httpClient),
+			WithProductionGuard(),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, EnvProduction, client.Environment())
+
+		_, err = client.CreatePerson(context.Background(), "person1", Person{})
+		assert.ErrorIs(t, err, ErrProductionWrite)
+
+		err = client.DeleteInvoice(context.Background(), "invoice1")
+		assert.ErrorIs(t, err, ErrProductionWrite)
+
+		err = client.SendInvoiceToErir(context.Background(), "invoice1")
+		assert.ErrorIs(t, err, ErrProductionWrite)
+
+		err = client.RequestCID(context.Background(), "contract1")
+		assert.ErrorIs(t, err, ErrProductionWrite)
+
+		_, err = client.UploadMedia(context.Background(), "media1", "test.txt", strings.NewReader("data"))
+		assert.ErrorIs(t, err, ErrProductionWrite)
+
+		assert.Empty(t, hosts, "mutating requests should not be sent")
+
+		_, err = client.GetPerson(context.Background(), "person1")
+		require.NoError(t, err)
+
+		_, err = client.PostErirStatuses(context.Background(), ErirStatusRequest{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"api.ord.vk.com", "api.ord.vk.com"}, hosts)
+	})
+
+	t.Run("ProductionWrites", func(t *testing.T) {
+		client, _ := NewClient(
+			WithEnvironment(EnvProduction),
+			WithHttpClient(httpClient),
+			WithProductionGuard(),
+			WithProductionWrites(),
+		)
+
+		_, err := client.CreatePerson(context.Background(), "person1", Person{})
+		require.NoError(t, err)
+	})
+
+	t.Run("Sandbox", func(t *testing.T) {
+		hosts = nil
+
+		client, _ := NewClient(
+			WithEnvironment(EnvSandbox),
+			WithHttpClient(httpClient),
+			WithProductionGuard(),
+		)
+		assert.Equal(t, EnvSandbox, client.Environment())
+
+		_, err := client.CreatePerson(context.Background(), "person1", Person{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"api-sandbox.ord.vk.com"}, hosts)
+	})
+}
+
+func TestWithEnvironment(t *testing.T) {
+	client, err := NewClient()
+	require.NoError(t, err)
+	assert.Equal(t, EnvProduction, client.Environment())
+
+	err = WithEnvironment(EnvSandbox)(client)
+	require.NoError(t, err)
+	assert.Equal(t, "https://api-sandbox.ord.vk.com", client.base)
+
+	err = WithBase("https://api-sandbox.ord.vk.com/")(client)
+	require.NoError(t, err)
+	assert.Equal(t, EnvSandbox, client.Environment())
+
+	err = WithBase("https://test.api.com")(client)
+	require.NoError(t, err)
+	assert.Equal(t, Environment(""), client.Environment())
+
+	_, err = NewClient(WithEnvironment("https://api-sandbox.ord.vk.co"))
+	require.Error(t, err)
+}
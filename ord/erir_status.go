@@ -4,24 +4,91 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 )
 
+// ErirStatus is the processing status of an advertising object in ERIR
+type ErirStatus string
+
+const (
+	ErirStatusProcessing ErirStatus = "processing" // в обработке на стороне ОРД VK или ЕРИР.
+	ErirStatusBad        ErirStatus = "bad"        // не прошёл проверку ОРД VK или ЕРИР.
+	ErirStatusVerified   ErirStatus = "verified"   // проверка ЕРИР пройдена успешно.
+)
+
+// AllErirStatuses returns all known ERIR statuses
+func AllErirStatuses() []ErirStatus {
+	return []ErirStatus{ErirStatusProcessing, ErirStatusBad, ErirStatusVerified}
+}
+
+// ParseErirStatus returns an error for unknown ERIR statuses
+func ParseErirStatus(s string) (ErirStatus, error) {
+	return parseEnum("erir status", s, AllErirStatuses())
+}
+
+func (s ErirStatus) Valid() bool {
+	return slices.Contains(AllErirStatuses(), s)
+}
+
+func (s ErirStatus) String() string {
+	return string(s)
+}
+
+// ErirDataType is the kind of an advertising object in ERIR status requests
+type ErirDataType string
+
+const (
+	ErirDataTypePerson     ErirDataType = "person"     // контрагент.
+	ErirDataTypeContract   ErirDataType = "contract"   // договор.
+	ErirDataTypeCID        ErirDataType = "cid"        // уникальный идентификатор изначального договора.
+	ErirDataTypeCreative   ErirDataType = "creative"   // креатив.
+	ErirDataTypePad        ErirDataType = "pad"        // рекламная площадка.
+	ErirDataTypeInvoice    ErirDataType = "invoice"    // акт.
+	ErirDataTypeStatistics ErirDataType = "statistics" // статистика.
+)
+
+// AllErirDataTypes returns all known ERIR data types
+func AllErirDataTypes() []ErirDataType {
+	return []ErirDataType{
+		ErirDataTypePerson,
+		ErirDataTypeContract,
+		ErirDataTypeCID,
+		ErirDataTypeCreative,
+		ErirDataTypePad,
+		ErirDataTypeInvoice,
+		ErirDataTypeStatistics,
+	}
+}
+
+// ParseErirDataType returns an error for unknown ERIR data types
+func ParseErirDataType(s string) (ErirDataType, error) {
+	return parseEnum("erir data type", s, AllErirDataTypes())
+}
+
+func (t ErirDataType) Valid() bool {
+	return slices.Contains(AllErirDataTypes(), t)
+}
+
+func (t ErirDataType) String() string {
+	return string(t)
+}
+
 type ErirStatusEntity struct {
-	ErirStatus      string   `json:"erir_status"`
-	UpdatedByUserTs string   `json:"updated_by_user_ts"`
-	FinalizedTs     *string  `json:"finalized_ts,omitempty"`
-	Messages        []string `json:"messages,omitempty"`
+	ErirStatus      ErirStatus `json:"erir_status"`
+	UpdatedByUserTs string     `json:"updated_by_user_ts"`
+	FinalizedTs     *string    `json:"finalized_ts,omitempty"`
+	Messages        []string   `json:"messages,omitempty"`
 }
 
 type ErirStatusEntityItem struct {
-	DataType        string   `json:"data_type"`
-	ExternalID      string   `json:"external_id"`
-	Name            string   `json:"name"`
-	ErirTaxStatus   string   `json:"erir_tax_status"`
-	ErirStatus      string   `json:"erir_status"`
-	UpdatedByUserTs string   `json:"updated_by_user_ts"`
-	FinalizedTs     *string  `json:"finalized_ts,omitempty"`
-	Messages        []string `json:"messages,omitempty"`
+	DataType        ErirDataType  `json:"data_type"`
+	ExternalID      string        `json:"external_id"`
+	Name            string        `json:"name"`
+	ErirTaxStatus   ErirTaxStatus `json:"erir_tax_status"`
+	ErirStatus      ErirStatus    `json:"erir_status"`
+	UpdatedByUserTs string        `json:"updated_by_user_ts"`
+	FinalizedTs     *string       `json:"finalized_ts,omitempty"`
+	Messages        []string      `json:"messages,omitempty"`
 }
 
 type ErirStatusEntities struct {
@@ -31,24 +98,24 @@ type ErirStatusEntities struct {
 	Items           []ErirStatusEntityItem `json:"items"`
 }
 
-func (c *Client) GetErirStatus(ctx context.Context, dataType, externalID string) (*ErirStatusEntity, error) {
+func (c *Client) GetErirStatus(ctx context.Context, dataType ErirDataType, externalID string) (*ErirStatusEntity, error) {
 	path := fmt.Sprintf("/v1/%s/%s/erir_status", dataType, externalID)
 
 	var status ErirStatusEntity
-	if err := c.request(ctx, "GET", path, nil, &status); err != nil {
+	if err := c.request(ctx, "GetErirStatus", externalID, "GET", path, nil, &status); err != nil {
 		return nil, fmt.Errorf("failed to get object processing status: %w", err)
 	}
 
 	return &status, nil
 }
 
-func (c *Client) GetErirStatuses(ctx context.Context, dataType, erirStatus string, offset, limit, limitPerEntity int, externalIDs []string) (*ErirStatusEntities, error) {
+func (c *Client) GetErirStatuses(ctx context.Context, dataType ErirDataType, erirStatus ErirStatus, offset, limit, limitPerEntity int, externalIDs []string) (*ErirStatusEntities, error) {
 	params := url.Values{}
 	if dataType != "" {
-		params.Set("data_type", dataType)
+		params.Set("data_type", dataType.String())
 	}
 	if erirStatus != "" {
-		params.Set("erir_status", erirStatus)
+		params.Set("erir_status", erirStatus.String())
 	}
 	params.Set("offset", fmt.Sprintf("%d", offset))
 	params.Set("limit", fmt.Sprintf("%d", limit))
@@ -61,7 +128,7 @@ func (c *Client) GetErirStatuses(ctx context.Context, dataType, erirStatus strin
 	path := "/v1/erir_statuses?" + params.Encode()
 
 	var statuses ErirStatusEntities
-	if err := c.request(ctx, "GET", path, nil, &statuses); err != nil {
+	if err := c.request(ctx, "GetErirStatuses", "", "GET", path, nil, &statuses); err != nil {
 		return nil, fmt.Errorf("failed to get ad object processing statuses: %w", err)
 	}
 
@@ -69,19 +136,19 @@ func (c *Client) GetErirStatuses(ctx context.Context, dataType, erirStatus strin
 }
 
 type ErirStatusRequest struct {
-	DataType       string   `json:"data_type,omitempty"`
-	ErirStatus     string   `json:"erir_status,omitempty"`
-	ExternalID     []string `json:"external_id,omitempty"`
-	Offset         int      `json:"offset,omitempty"`
-	Limit          int      `json:"limit,omitempty"`
-	LimitPerEntity int      `json:"limit_per_entity,omitempty"`
+	DataType       ErirDataType `json:"data_type,omitempty"`
+	ErirStatus     ErirStatus   `json:"erir_status,omitempty"`
+	ExternalID     []string     `json:"external_id,omitempty"`
+	Offset         int          `json:"offset,omitempty"`
+	Limit          int          `json:"limit,omitempty"`
+	LimitPerEntity int          `json:"limit_per_entity,omitempty"`
 }
 
 func (c *Client) PostErirStatuses(ctx context.Context, request ErirStatusRequest) (*ErirStatusEntities, error) {
 	path := "/v1/erir_statuses"
 
 	var statuses ErirStatusEntities
-	if err := c.request(ctx, "POST", path, request, &statuses); err != nil {
+	if err := c.request(ctx, "PostErirStatuses", "", "POST", path, request, &statuses); err != nil {
 		return nil, fmt.Errorf("failed to post ad object processing statuses: %w", err)
 	}
 
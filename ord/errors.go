@@ -0,0 +1,88 @@
+package ord
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrUnauthorized = errors.New("unauthorized") // 401, неверный или отсутствующий токен.
+	ErrNotFound     = errors.New("not found")    // 404, объект не найден.
+	ErrGone         = errors.New("gone")         // 410, объект удален.
+)
+
+// APIErrorItem represents a single error from the errors list (CommonErrorObject400)
+type APIErrorItem struct {
+	ErrorCode  string   `json:"error_code"`
+	Message    string   `json:"message"`
+	Values     []string `json:"values,omitempty"`
+	Field      string   `json:"field,omitempty"`
+	QueryParam string   `json:"query_param,omitempty"`
+	PathParam  string   `json:"path_param,omitempty"`
+}
+
+// APIError represents a non-2xx response of the ORD VK API
+type APIError struct {
+	StatusCode int            `json:"-"`
+	Message    string         `json:"error"`
+	Errors     []APIErrorItem `json:"errors,omitempty"`
+	Body       []byte         `json:"-"`
+}
+
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Body:       body,
+	}
+
+	// тело ответа может быть не JSON (например, от балансировщика), тогда оставляем только Body
+	_ = json.Unmarshal(body, apiErr)
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" && len(e.Errors) == 0 {
+		msg = strings.TrimSpace(string(e.Body))
+	}
+
+	for _, item := range e.Errors {
+		msg += "; " + item.String()
+	}
+
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, strings.TrimPrefix(msg, "; "))
+}
+
+// Is allows matching APIError with ErrUnauthorized, ErrNotFound and ErrGone via errors.Is
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrGone:
+		return e.StatusCode == http.StatusGone
+	}
+
+	return false
+}
+
+func (i APIErrorItem) String() string {
+	name := i.Field
+	if name == "" {
+		name = i.QueryParam
+	}
+	if name == "" {
+		name = i.PathParam
+	}
+
+	if name == "" {
+		return fmt.Sprintf("%s: %s", i.ErrorCode, i.Message)
+	}
+
+	return fmt.Sprintf("%s: %s (%s)", i.ErrorCode, i.Message, name)
+}
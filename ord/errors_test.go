@@ -0,0 +1,89 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": "Bad Request", "errors": [{"error_code": "common_length_out_of_ranges", "message": "external_id is too long", "values": ["my-very-long-external-id"], "path_param": "external_id"}]}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+	)
+
+	_, err := client.CreatePerson(context.Background(), "my-very-long-external-id", Person{})
+	require.Error(t, err)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), "error should unwrap to *APIError")
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "Bad Request", apiErr.Message)
+	require.Len(t, apiErr.Errors, 1)
+	assert.Equal(t, "common_length_out_of_ranges", apiErr.Errors[0].ErrorCode)
+	assert.Equal(t, "external_id is too long", apiErr.Errors[0].Message)
+	assert.Equal(t, []string{"my-very-long-external-id"}, apiErr.Errors[0].Values)
+	assert.Equal(t, "external_id", apiErr.Errors[0].PathParam)
+	assert.Contains(t, err.Error(), "failed to create person")
+	assert.Contains(t, err.Error(), "external_id is too long")
+}
+
+func TestClient_APIError_Sentinels(t *testing.T) {
+	tests := []struct {
+		status int
+		target error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusGone, ErrGone},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"error": "error", "errors": []}`)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient(
+				WithBase(server.URL),
+				WithToken("test-token"),
+			)
+
+			_, err := client.GetContract(context.Background(), "contract1")
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.target))
+
+			_, err = client.GetMediaBinary(context.Background(), "media1")
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.target))
+
+			_, err = client.UploadMedia(context.Background(), "media1", "test.txt", strings.NewReader("data"))
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.target))
+		})
+	}
+}
+
+func TestAPIError_PlainBody(t *testing.T) {
+	err := newAPIError(http.StatusBadGateway, []byte("Bad Gateway\n"))
+
+	assert.Equal(t, "API request failed with status 502: Bad Gateway", err.Error())
+	assert.False(t, errors.Is(err, ErrNotFound))
+}
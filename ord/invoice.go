@@ -2,31 +2,143 @@ package ord
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 )
 
+// ErrInvoiceBlocked is returned by EnsureInvoiceModifiable for invoices with the blocked tax status
+var ErrInvoiceBlocked = errors.New("invoice is blocked for changes")
+
+// InvoiceClientRoleType is a role of a party of an invoice
+type InvoiceClientRoleType string
+
+const (
+	InvoiceClientRoleTypeAdvertiser InvoiceClientRoleType = "advertiser" // рекламодатель.
+	InvoiceClientRoleTypeAgency     InvoiceClientRoleType = "agency"     // рекламное агентство.
+	InvoiceClientRoleTypeOrs        InvoiceClientRoleType = "ors"        // оператор рекламной системы.
+	InvoiceClientRoleTypePublisher  InvoiceClientRoleType = "publisher"  // издатель, рекламораспространитель.
+	InvoiceClientRoleTypeMediator   InvoiceClientRoleType = "mediator"   // посредник
+)
+
+// AllInvoiceClientRoleTypes returns all known invoice party roles
+func AllInvoiceClientRoleTypes() []InvoiceClientRoleType {
+	return []InvoiceClientRoleType{
+		InvoiceClientRoleTypeAdvertiser,
+		InvoiceClientRoleTypeAgency,
+		InvoiceClientRoleTypeOrs,
+		InvoiceClientRoleTypePublisher,
+		InvoiceClientRoleTypeMediator,
+	}
+}
+
+// ParseInvoiceClientRoleType returns an error for unknown invoice party roles
+func ParseInvoiceClientRoleType(s string) (InvoiceClientRoleType, error) {
+	return parseEnum("invoice role", s, AllInvoiceClientRoleTypes())
+}
+
+func (t InvoiceClientRoleType) Valid() bool {
+	return slices.Contains(AllInvoiceClientRoleTypes(), t)
+}
+
+func (t InvoiceClientRoleType) String() string {
+	return string(t)
+}
+
+// InvoiceStatus is a status of an invoice returned by GetInvoice
+type InvoiceStatus string
+
 const (
-	InvoiceClientRoleTypeAdvertiser = "advertiser" // рекламодатель.
-	InvoiceClientRoleTypeAgency     = "agency"     // рекламное агентство.
-	InvoiceClientRoleTypeOrs        = "ors"        // оператор рекламной системы.
-	InvoiceClientRoleTypePublisher  = "publisher"  // издатель, рекламораспространитель.
-	InvoiceClientRoleTypeMediator   = "mediator"   // посредник
+	InvoiceStatusDeleted InvoiceStatus = "deleted" // акт удален.
+	InvoiceStatusDraft   InvoiceStatus = "draft"   // акт-черновик, не отправляется в ЕРИР.
 )
 
+// AllInvoiceStatuses returns all known invoice statuses
+func AllInvoiceStatuses() []InvoiceStatus {
+	return []InvoiceStatus{
+		InvoiceStatusDeleted,
+		InvoiceStatusDraft,
+	}
+}
+
+// ParseInvoiceStatus returns an error for unknown invoice statuses
+func ParseInvoiceStatus(s string) (InvoiceStatus, error) {
+	return parseEnum("invoice status", s, AllInvoiceStatuses())
+}
+
+func (s InvoiceStatus) Valid() bool {
+	return slices.Contains(AllInvoiceStatuses(), s)
+}
+
+func (s InvoiceStatus) String() string {
+	return string(s)
+}
+
+// ErirTaxStatus is a status of the ERIR fee charged for an invoice
+type ErirTaxStatus string
+
+const (
+	ErirTaxStatusNoTax      ErirTaxStatus = "no_tax"     // сбор не начислен.
+	ErirTaxStatusCalculated ErirTaxStatus = "calculated" // сбор рассчитан и будет начислен.
+	ErirTaxStatusBlocked    ErirTaxStatus = "blocked"    // сбор начислен, акт заблокирован для изменений.
+)
+
+// AllErirTaxStatuses returns all known ERIR tax statuses
+func AllErirTaxStatuses() []ErirTaxStatus {
+	return []ErirTaxStatus{
+		ErirTaxStatusNoTax,
+		ErirTaxStatusCalculated,
+		ErirTaxStatusBlocked,
+	}
+}
+
+// ParseErirTaxStatus returns an error for unknown ERIR tax statuses
+func ParseErirTaxStatus(s string) (ErirTaxStatus, error) {
+	return parseEnum("erir tax status", s, AllErirTaxStatuses())
+}
+
+func (s ErirTaxStatus) Valid() bool {
+	return slices.Contains(AllErirTaxStatuses(), s)
+}
+
+func (s ErirTaxStatus) String() string {
+	return string(s)
+}
+
+// InvoiceVatRateWithoutVat is the vat_rate value for amounts without VAT
+const InvoiceVatRateWithoutVat = "without_vat"
+
 type Invoice struct {
-	ContractExternalID      string        `json:"contract_external_id"`
-	OrderContractExternalID *string       `json:"order_contract_external_id,omitempty"`
-	Date                    string        `json:"date"`
-	Serial                  *string       `json:"serial,omitempty"`
-	DateStart               string        `json:"date_start"`
-	DateEnd                 string        `json:"date_end"`
-	Amount                  InvoiceAmount `json:"amount"`
-	ClientRole              string        `json:"client_role"`
-	ContractorRole          string        `json:"contractor_role"`
-	Flags                   []string      `json:"flags,omitempty"`
-	Items                   []InvoiceItem `json:"items,omitempty"`
-	Status                  *string       `json:"status,omitempty"`
-	ErirTaxStatus           *string       `json:"erir_tax_status,omitempty"`
+	ContractExternalID      string                `json:"contract_external_id"`
+	OrderContractExternalID *string               `json:"order_contract_external_id,omitempty"`
+	Date                    string                `json:"date"`
+	Serial                  *string               `json:"serial,omitempty"`
+	DateStart               string                `json:"date_start"`
+	DateEnd                 string                `json:"date_end"`
+	Amount                  InvoiceAmount         `json:"amount"`
+	ClientRole              InvoiceClientRoleType `json:"client_role"`
+	ContractorRole          InvoiceClientRoleType `json:"contractor_role"`
+	Flags                   []string              `json:"flags,omitempty"`
+	Items                   []InvoiceItem         `json:"items,omitempty"`
+	Status                  *InvoiceStatus        `json:"status,omitempty"`          // только в ответе GetInvoice.
+	ErirTaxStatus           *ErirTaxStatus        `json:"erir_tax_status,omitempty"` // только в ответе GetInvoice.
+}
+
+// request clears the response-only fields, so an invoice from GetInvoice can be sent back after editing
+func (i Invoice) request() Invoice {
+	i.Status, i.ErirTaxStatus = nil, nil
+
+	return i
+}
+
+// IsDraft reports whether the invoice was created as a draft
+func (i Invoice) IsDraft() bool {
+	return i.Status != nil && *i.Status == InvoiceStatusDraft
+}
+
+// IsBlocked reports whether the ERIR fee is charged and the invoice can't be changed anymore
+func (i Invoice) IsBlocked() bool {
+	return i.ErirTaxStatus != nil && *i.ErirTaxStatus == ErirTaxStatusBlocked
 }
 
 type InvoiceAmount struct {
@@ -69,7 +181,98 @@ type InvoiceCreativePlatform struct {
 	DateEndPlanned    string             `json:"date_end_planned"`
 	DateStartActual   string             `json:"date_start_actual"`
 	DateEndActual     string             `json:"date_end_actual"`
-	PayType           string             `json:"pay_type"`
+	PayType           StatisticsPayType  `json:"pay_type"`
+}
+
+// InvoiceHeader is the header of Invoice with typed dates, use it with CreateInvoiceHeader
+type InvoiceHeader struct {
+	ContractExternalID      string
+	OrderContractExternalID *string
+	Date                    Date
+	Serial                  *string
+	DateStart               Date
+	DateEnd                 Date
+	Amount                  InvoiceAmount
+	ClientRole              InvoiceClientRoleType
+	ContractorRole          InvoiceClientRoleType
+	Flags                   []string
+}
+
+// Invoice converts the typed header into Invoice
+func (h InvoiceHeader) Invoice() Invoice {
+	return Invoice{
+		ContractExternalID:      h.ContractExternalID,
+		OrderContractExternalID: h.OrderContractExternalID,
+		Date:                    h.Date.String(),
+		Serial:                  h.Serial,
+		DateStart:               h.DateStart.String(),
+		DateEnd:                 h.DateEnd.String(),
+		Amount:                  h.Amount,
+		ClientRole:              h.ClientRole,
+		ContractorRole:          h.ContractorRole,
+		Flags:                   h.Flags,
+	}
+}
+
+// DeleteContractsFromInvoiceRequest describes what to delete from an invoice. For every item
+// the deepest listed level is deleted: platforms if they are set, otherwise creatives,
+// otherwise the whole contract
+type DeleteContractsFromInvoiceRequest struct {
+	Items []InvoiceDeleteItem `json:"items"`
+}
+
+// InvoiceDeleteItem is an initial contract of an invoice, set either ContractExternalID or Cid
+type InvoiceDeleteItem struct {
+	ContractExternalID *string                 `json:"contract_external_id,omitempty"`
+	Cid                *string                 `json:"cid,omitempty"`
+	Creatives          []InvoiceDeleteCreative `json:"creatives,omitempty"`
+}
+
+type InvoiceDeleteCreative struct {
+	CreativeExternalID string                  `json:"creative_external_id"`
+	Platforms          []InvoiceDeletePlatform `json:"platforms,omitempty"`
+}
+
+type InvoiceDeletePlatform struct {
+	PadExternalID string `json:"pad_external_id"`
+}
+
+// Validate checks that every item has exactly one of contract_external_id and cid
+// and that creatives and platforms have identifiers
+func (r DeleteContractsFromInvoiceRequest) Validate() error {
+	v := &validator{}
+
+	if len(r.Items) == 0 {
+		v.add("items", "is required")
+	}
+
+	for n, item := range r.Items {
+		prefix := fmt.Sprintf("items[%d]", n)
+
+		hasContract := item.ContractExternalID != nil && *item.ContractExternalID != ""
+		hasCid := item.Cid != nil && *item.Cid != ""
+		switch {
+		case !hasContract && !hasCid:
+			v.add(prefix, "contract_external_id or cid is required")
+		case hasContract && hasCid:
+			v.add(prefix, "contract_external_id and cid are mutually exclusive")
+		}
+
+		for k, creative := range item.Creatives {
+			path := fmt.Sprintf("%s.creatives[%d]", prefix, k)
+			if creative.CreativeExternalID == "" {
+				v.add(path+".creative_external_id", "is required")
+			}
+
+			for m, platform := range creative.Platforms {
+				if platform.PadExternalID == "" {
+					v.add(fmt.Sprintf("%s.platforms[%d].pad_external_id", path, m), "is required")
+				}
+			}
+		}
+	}
+
+	return v.err()
 }
 
 type InvoiceListResponse struct {
@@ -82,7 +285,7 @@ func (c *Client) GetInvoices(ctx context.Context, offset, limit int) (*InvoiceLi
 	path := fmt.Sprintf("/v1/invoice?offset=%d&limit=%d", offset, limit)
 
 	var response InvoiceListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetInvoices", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get invoices: %w", err)
 	}
 
@@ -93,7 +296,7 @@ func (c *Client) GetInvoice(ctx context.Context, externalID string) (*Invoice, e
 	path := fmt.Sprintf("/v4/invoice/%s", externalID)
 
 	var invoice Invoice
-	if err := c.request(ctx, "GET", path, nil, &invoice); err != nil {
+	if err := c.request(ctx, "GetInvoice", externalID, "GET", path, nil, &invoice); err != nil {
 		return nil, fmt.Errorf("failed to get invoice: %w", err)
 	}
 
@@ -101,9 +304,27 @@ func (c *Client) GetInvoice(ctx context.Context, externalID string) (*Invoice, e
 }
 
 func (c *Client) CreateInvoiceHeader(ctx context.Context, externalID string, invoice Invoice) error {
+	return c.createInvoiceHeader(ctx, externalID, invoice, false)
+}
+
+// CreateInvoiceHeaderDraft creates or updates an invoice header as a draft, drafts are not sent to ERIR
+func (c *Client) CreateInvoiceHeaderDraft(ctx context.Context, externalID string, invoice Invoice) error {
+	return c.createInvoiceHeader(ctx, externalID, invoice, true)
+}
+
+func (c *Client) createInvoiceHeader(ctx context.Context, externalID string, invoice Invoice, draft bool) error {
+	if err := c.guardInvoice(ctx, externalID); err != nil {
+		return fmt.Errorf("failed to create invoice header: %w", err)
+	}
+
+	invoice = invoice.request()
+
 	path := fmt.Sprintf("/v4/invoice/%s/header", externalID)
+	if draft {
+		path += "?draft=true"
+	}
 
-	if err := c.request(ctx, "PUT", path, invoice, nil); err != nil {
+	if err := c.request(ctx, "CreateInvoiceHeader", externalID, "PUT", path, invoice, nil); err != nil {
 		return fmt.Errorf("failed to create invoice header: %w", err)
 	}
 
@@ -111,13 +332,17 @@ func (c *Client) CreateInvoiceHeader(ctx context.Context, externalID string, inv
 }
 
 func (c *Client) AddContractsToInvoice(ctx context.Context, externalID string, items []InvoiceItem) error {
+	if err := c.guardInvoice(ctx, externalID); err != nil {
+		return fmt.Errorf("failed to add contracts to invoice: %w", err)
+	}
+
 	path := fmt.Sprintf("/v4/invoice/%s/items", externalID)
 
 	requestBody := map[string]interface{}{
 		"items": items,
 	}
 
-	if err := c.request(ctx, "PATCH", path, requestBody, nil); err != nil {
+	if err := c.request(ctx, "AddContractsToInvoice", externalID, "PATCH", path, requestBody, nil); err != nil {
 		return fmt.Errorf("failed to add contracts to invoice: %w", err)
 	}
 
@@ -125,9 +350,13 @@ func (c *Client) AddContractsToInvoice(ctx context.Context, externalID string, i
 }
 
 func (c *Client) DeleteInvoice(ctx context.Context, externalID string) error {
+	if err := c.guardInvoice(ctx, externalID); err != nil {
+		return fmt.Errorf("failed to delete invoice: %w", err)
+	}
+
 	path := fmt.Sprintf("/v4/invoice/%s", externalID)
 
-	if err := c.request(ctx, "DELETE", path, nil, nil); err != nil {
+	if err := c.request(ctx, "DeleteInvoice", externalID, "DELETE", path, nil, nil); err != nil {
 		return fmt.Errorf("failed to delete invoice: %w", err)
 	}
 
@@ -137,17 +366,21 @@ func (c *Client) DeleteInvoice(ctx context.Context, externalID string) error {
 func (c *Client) SendInvoiceToErir(ctx context.Context, externalID string) error {
 	path := fmt.Sprintf("/v4/invoice/%s/ready", externalID)
 
-	if err := c.request(ctx, "POST", path, nil, nil); err != nil {
+	if err := c.request(ctx, "SendInvoiceToErir", externalID, "POST", path, nil, nil); err != nil {
 		return fmt.Errorf("failed to send invoice to ERIR: %w", err)
 	}
 
 	return nil
 }
 
-func (c *Client) DeleteContractsFromInvoice(ctx context.Context, externalID string, deleteInfo interface{}) error {
+func (c *Client) DeleteContractsFromInvoice(ctx context.Context, externalID string, request DeleteContractsFromInvoiceRequest) error {
+	if err := c.guardInvoice(ctx, externalID); err != nil {
+		return fmt.Errorf("failed to delete contracts from invoice: %w", err)
+	}
+
 	path := fmt.Sprintf("/v4/invoice/%s/delete", externalID)
 
-	if err := c.request(ctx, "POST", path, deleteInfo, nil); err != nil {
+	if err := c.request(ctx, "DeleteContractsFromInvoice", externalID, "POST", path, request, nil); err != nil {
 		return fmt.Errorf("failed to delete contracts from invoice: %w", err)
 	}
 
@@ -155,11 +388,247 @@ func (c *Client) DeleteContractsFromInvoice(ctx context.Context, externalID stri
 }
 
 func (c *Client) CreateWholeInvoice(ctx context.Context, externalID string, invoice Invoice) error {
+	return c.createWholeInvoice(ctx, externalID, invoice, false)
+}
+
+// CreateWholeInvoiceDraft creates or updates a whole invoice as a draft, drafts are not sent to ERIR
+func (c *Client) CreateWholeInvoiceDraft(ctx context.Context, externalID string, invoice Invoice) error {
+	return c.createWholeInvoice(ctx, externalID, invoice, true)
+}
+
+func (c *Client) createWholeInvoice(ctx context.Context, externalID string, invoice Invoice, draft bool) error {
+	if err := c.guardInvoice(ctx, externalID); err != nil {
+		return fmt.Errorf("failed to create whole invoice: %w", err)
+	}
+
+	invoice = invoice.request()
+
 	path := fmt.Sprintf("/v4/invoice/%s", externalID)
+	if draft {
+		path += "?draft=true"
+	}
 
-	if err := c.request(ctx, "PUT", path, invoice, nil); err != nil {
+	if err := c.request(ctx, "CreateWholeInvoice", externalID, "PUT", path, invoice, nil); err != nil {
 		return fmt.Errorf("failed to create whole invoice: %w", err)
 	}
 
 	return nil
 }
+
+// EnsureInvoiceModifiable returns ErrInvoiceBlocked if the ERIR fee for the invoice is already
+// charged and the API will reject any changes. Invoices that don't exist yet can be created.
+// With WithInvoiceGuard it is called by all methods changing invoices
+func (c *Client) EnsureInvoiceModifiable(ctx context.Context, externalID string) error {
+	invoice, err := c.GetInvoice(ctx, externalID)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if invoice.IsBlocked() {
+		return fmt.Errorf("%w: %s", ErrInvoiceBlocked, externalID)
+	}
+
+	return nil
+}
+
+// guardInvoice calls EnsureInvoiceModifiable if WithInvoiceGuard is set
+func (c *Client) guardInvoice(ctx context.Context, externalID string) error {
+	if !c.invoiceGuard {
+		return nil
+	}
+
+	return c.EnsureInvoiceModifiable(ctx, externalID)
+}
+
+// Validate checks invoice roles, the arithmetic of every amount group, that items and platforms
+// add up to the amounts above them and that actual platform dates are inside the invoice period
+func (i Invoice) Validate() error {
+	v := &validator{}
+
+	roles := []struct {
+		field string
+		role  InvoiceClientRoleType
+	}{
+		{"client_role", i.ClientRole},
+		{"contractor_role", i.ContractorRole},
+	}
+	for _, r := range roles {
+		switch {
+		case r.role == "":
+			v.add(r.field, "is required")
+		case !r.role.Valid():
+			v.add(r.field, "unknown role %q", r.role)
+		}
+	}
+
+	start, startErr := ParseDate(i.DateStart)
+	if startErr != nil {
+		v.add("date_start", "must be a date in YYYY-MM-DD format")
+	}
+	end, endErr := ParseDate(i.DateEnd)
+	if endErr != nil {
+		v.add("date_end", "must be a date in YYYY-MM-DD format")
+	}
+	period := startErr == nil && endErr == nil
+	if period && end.Before(start) {
+		v.add("date_end", "must not be before date_start")
+	}
+
+	i.Amount.Services.validate(v, "amount.services")
+	if i.Amount.Commission != nil {
+		i.Amount.Commission.Amount.validate(v, "amount.commission.amount")
+	}
+
+	items := make([]InvoiceAmountGroup, 0, len(i.Items))
+	for n, item := range i.Items {
+		prefix := fmt.Sprintf("items[%d]", n)
+		item.Amount.validate(v, prefix+".amount")
+		items = append(items, item.Amount)
+
+		var platforms []InvoiceAmountGroup
+		for k, creative := range item.Creatives {
+			for m, platform := range creative.Platforms {
+				path := fmt.Sprintf("%s.creatives[%d].platforms[%d]", prefix, k, m)
+				platform.Amount.validate(v, path+".amount")
+				platforms = append(platforms, platform.Amount)
+
+				if platform.PayType != "" && !platform.PayType.Valid() {
+					v.add(path+".pay_type", "unknown pay type %q", platform.PayType)
+				}
+
+				if period {
+					validateInPeriod(v, path+".date_start_actual", platform.DateStartActual, start, end)
+					validateInPeriod(v, path+".date_end_actual", platform.DateEndActual, start, end)
+				}
+			}
+		}
+
+		if len(platforms) > 0 {
+			validateSum(v, prefix+".amount", item.Amount, platforms)
+		}
+	}
+
+	if len(items) > 0 {
+		validateSum(v, "amount.services", i.Amount.Services, items)
+	}
+
+	return v.err()
+}
+
+// validate checks that excluding_vat + vat = including_vat and that vat matches vat_rate
+// with the tolerance described in the API docs
+func (g InvoiceAmountGroup) validate(v *validator, path string) {
+	excluding, ok1 := parseAmount(v, path+".excluding_vat", g.ExcludingVat)
+	vat, ok2 := parseAmount(v, path+".vat", g.Vat)
+	including, ok3 := parseAmount(v, path+".including_vat", g.IncludingVat)
+	if !ok1 || !ok2 || !ok3 {
+		return
+	}
+
+	if excluding.Add(vat).Cmp(including) != 0 {
+		v.add(path+".including_vat", "must be equal to excluding_vat + vat")
+	}
+
+	if g.VatRate == "" {
+		return
+	}
+
+	if g.VatRate == InvoiceVatRateWithoutVat {
+		if !vat.IsZero() {
+			v.add(path+".vat", "must be 0 for %s", InvoiceVatRateWithoutVat)
+		}
+		return
+	}
+
+	rate, err := ParseMoney(g.VatRate)
+	if err != nil || rate.Sign() < 0 {
+		v.add(path+".vat_rate", "must be a percent or %s", InvoiceVatRateWithoutVat)
+		return
+	}
+
+	lo, hi := vatRange(excluding, rate)
+	if vat.Cmp(lo) < 0 || vat.Cmp(hi) > 0 {
+		v.add(path+".vat", "must be between %s and %s for vat_rate %s", lo, hi, g.VatRate)
+	}
+}
+
+// vatRange returns the allowed range of the VAT amount for the base and the rate in percent
+func vatRange(base, rate Money) (Money, Money) {
+	calc := base.Percent(rate)
+	half := base.Mul(NewMoney(5, 1))
+
+	switch {
+	case calc.Cmp(NewMoney(5, 1)) >= 0:
+		return calc.Floor(2), calc.Round(2).Add(NewMoney(5, 1))
+	case calc.Cmp(NewMoney(1, 1)) >= 0:
+		return calc.Floor(2), minMoney(calc.Round(2).Add(NewMoney(5, 2)), half)
+	default:
+		scale := min(max(base.trim(0).Scale(), 2), 5)
+		return calc.Floor(scale), minMoney(calc.Round(scale).Add(NewMoney(5, scale)), half)
+	}
+}
+
+func validateSum(v *validator, path string, total InvoiceAmountGroup, parts []InvoiceAmountGroup) {
+	fields := []struct {
+		name  string
+		total string
+		part  func(InvoiceAmountGroup) string
+	}{
+		{"excluding_vat", total.ExcludingVat, func(g InvoiceAmountGroup) string { return g.ExcludingVat }},
+		{"vat", total.Vat, func(g InvoiceAmountGroup) string { return g.Vat }},
+		{"including_vat", total.IncludingVat, func(g InvoiceAmountGroup) string { return g.IncludingVat }},
+	}
+
+	for _, f := range fields {
+		want, err := ParseMoney(f.total)
+		if err != nil {
+			continue
+		}
+
+		sum := Money{}
+		for _, part := range parts {
+			amount, err := ParseMoney(f.part(part))
+			if err != nil {
+				return
+			}
+			sum = sum.Add(amount)
+		}
+
+		if sum.Cmp(want) != 0 {
+			v.add(path+"."+f.name, "must be equal to the sum of nested amounts %s", sum)
+		}
+	}
+}
+
+func validateInPeriod(v *validator, field, value string, start, end Date) {
+	date, err := ParseDate(value)
+	if err != nil {
+		v.add(field, "must be a date in YYYY-MM-DD format")
+		return
+	}
+
+	if date.Before(start) || date.After(end) {
+		v.add(field, "must be between date_start and date_end of the invoice")
+	}
+}
+
+func parseAmount(v *validator, field, value string) (Money, bool) {
+	m, err := ParseMoney(value)
+	if err != nil || m.Sign() < 0 {
+		v.add(field, "must be a non-negative decimal number")
+		return Money{}, false
+	}
+
+	return m, true
+}
+
+func minMoney(a, b Money) Money {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+
+	return b
+}
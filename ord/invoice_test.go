@@ -4,6 +4,8 @@ package ord
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -302,10 +304,14 @@ func TestClient_SendInvoiceToErir_Error(t *testing.T) {
 }
 
 func TestClient_DeleteContractsFromInvoice(t *testing.T) {
-	deleteInfo := map[string]interface{}{
-		"items": []map[string]interface{}{
+	deleteInfo := DeleteContractsFromInvoiceRequest{
+		Items: []InvoiceDeleteItem{
+			{ContractExternalID: Ptr("test-contract-id")},
 			{
-				"contract_external_id": "test-contract-id",
+				Cid: Ptr("test-cid"),
+				Creatives: []InvoiceDeleteCreative{
+					{CreativeExternalID: "creative1", Platforms: []InvoiceDeletePlatform{{PadExternalID: "pad1"}}},
+				},
 			},
 		},
 	}
@@ -314,9 +320,9 @@ func TestClient_DeleteContractsFromInvoice(t *testing.T) {
 		assert.Equal(t, "POST", r.Method, "Expected POST request")
 		assert.Equal(t, "/v4/invoice/test-invoice-id/delete", r.URL.Path, "Expected path /v4/invoice/test-invoice-id/delete")
 
-		var reqBody map[string]interface{}
-		err := json.NewDecoder(r.Body).Decode(&reqBody)
-		assert.NoError(t, err, "Should be able to decode request body")
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err, "Should be able to read request body")
+		assert.JSONEq(t, `{"items":[{"contract_external_id":"test-contract-id"},{"cid":"test-cid","creatives":[{"creative_external_id":"creative1","platforms":[{"pad_external_id":"pad1"}]}]}]}`, string(body))
 
 		w.WriteHeader(http.StatusOK)
 	}))
@@ -342,7 +348,7 @@ func TestClient_DeleteContractsFromInvoice_Error(t *testing.T) {
 		WithToken("test-token"),
 	)
 
-	err := client.DeleteContractsFromInvoice(context.Background(), "test-invoice-id", map[string]interface{}{})
+	err := client.DeleteContractsFromInvoice(context.Background(), "test-invoice-id", DeleteContractsFromInvoiceRequest{})
 	require.Error(t, err, "DeleteContractsFromInvoice should return an error")
 }
 
@@ -406,3 +412,243 @@ func TestClient_CreateWholeInvoice_Error(t *testing.T) {
 	err := client.CreateWholeInvoice(context.Background(), "test-invoice-id", Invoice{})
 	require.Error(t, err, "CreateWholeInvoice should return an error")
 }
+
+func TestClient_CreateInvoiceDraft(t *testing.T) {
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Path+"?"+r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithBase(server.URL), WithToken("test-token"))
+
+	require.NoError(t, client.CreateWholeInvoiceDraft(context.Background(), "invoice1", Invoice{}))
+	require.NoError(t, client.CreateInvoiceHeaderDraft(context.Background(), "invoice1", Invoice{}))
+	require.NoError(t, client.CreateWholeInvoice(context.Background(), "invoice1", Invoice{}))
+
+	assert.Equal(t, []string{
+		"/v4/invoice/invoice1?draft=true",
+		"/v4/invoice/invoice1/header?draft=true",
+		"/v4/invoice/invoice1?",
+	}, queries)
+}
+
+func TestClient_CreateWholeInvoice_ClearsResponseFields(t *testing.T) {
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithBase(server.URL), WithToken("test-token"))
+
+	invoice := Invoice{
+		ContractExternalID: "contract1",
+		Status:             Ptr(InvoiceStatusDraft),
+		ErirTaxStatus:      Ptr(ErirTaxStatusCalculated),
+	}
+	require.NoError(t, client.CreateWholeInvoice(context.Background(), "invoice1", invoice))
+	require.NoError(t, client.CreateInvoiceHeader(context.Background(), "invoice1", invoice))
+
+	require.Len(t, bodies, 2)
+	for _, body := range bodies {
+		assert.NotContains(t, body, `"status"`)
+		assert.NotContains(t, body, `"erir_tax_status"`)
+	}
+	assert.NotNil(t, invoice.Status, "the caller's invoice should not be modified")
+}
+
+func TestClient_EnsureInvoiceModifiable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v4/invoice/blocked":
+			fmt.Fprint(w, `{"contract_external_id":"contract1","status":"draft","erir_tax_status":"blocked"}`)
+		case "/v4/invoice/calculated":
+			fmt.Fprint(w, `{"contract_external_id":"contract1","erir_tax_status":"calculated"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"Not found"}`)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithBase(server.URL), WithToken("test-token"))
+
+	invoice, err := client.GetInvoice(context.Background(), "blocked")
+	require.NoError(t, err)
+	assert.True(t, invoice.IsBlocked())
+	assert.True(t, invoice.IsDraft())
+	assert.Equal(t, ErirTaxStatusBlocked, *invoice.ErirTaxStatus)
+
+	assert.ErrorIs(t, client.EnsureInvoiceModifiable(context.Background(), "blocked"), ErrInvoiceBlocked)
+	assert.NoError(t, client.EnsureInvoiceModifiable(context.Background(), "calculated"))
+	assert.NoError(t, client.EnsureInvoiceModifiable(context.Background(), "missing"))
+}
+
+func TestDeleteContractsFromInvoiceRequest_Validate(t *testing.T) {
+	valid := DeleteContractsFromInvoiceRequest{Items: []InvoiceDeleteItem{{ContractExternalID: Ptr("contract1")}}}
+	require.NoError(t, valid.Validate())
+
+	invalid := DeleteContractsFromInvoiceRequest{
+		Items: []InvoiceDeleteItem{
+			{},
+			{ContractExternalID: Ptr("contract1"), Cid: Ptr("cid1")},
+			{Cid: Ptr("cid1"), Creatives: []InvoiceDeleteCreative{{Platforms: []InvoiceDeletePlatform{{}}}}},
+		},
+	}
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, invalid.Validate(), &verrs)
+	assert.True(t, verrs.Has("items[0]"))
+	assert.True(t, verrs.Has("items[1]"))
+	assert.True(t, verrs.Has("items[2].creatives[0].creative_external_id"))
+	assert.True(t, verrs.Has("items[2].creatives[0].platforms[0].pad_external_id"))
+
+	var empty ValidationErrors
+	require.ErrorAs(t, DeleteContractsFromInvoiceRequest{}.Validate(), &empty)
+	assert.True(t, empty.Has("items"))
+}
+
+func TestInvoice_Validate(t *testing.T) {
+	platform := func(excluding, vat, including string) InvoiceCreativePlatform {
+		return InvoiceCreativePlatform{
+			PadExternalID:   "pad1",
+			Amount:          InvoiceAmountGroup{ExcludingVat: excluding, VatRate: "20", Vat: vat, IncludingVat: including},
+			DateStartActual: "2023-01-01",
+			DateEndActual:   "2023-01-31",
+			PayType:         StatisticsPayTypeCPM,
+		}
+	}
+
+	valid := Invoice{
+		ContractExternalID: "contract1",
+		Date:               "2023-02-01",
+		DateStart:          "2023-01-01",
+		DateEnd:            "2023-01-31",
+		Amount: InvoiceAmount{
+			Services: InvoiceAmountGroup{ExcludingVat: "1000.00", VatRate: "20", Vat: "200.00", IncludingVat: "1200.00"},
+		},
+		ClientRole:     InvoiceClientRoleTypeAdvertiser,
+		ContractorRole: InvoiceClientRoleTypePublisher,
+		Items: []InvoiceItem{{
+			ContractExternalID: Ptr("contract2"),
+			Amount:             InvoiceAmountGroup{ExcludingVat: "1000.00", VatRate: "20", Vat: "200.00", IncludingVat: "1200.00"},
+			Creatives: []InvoiceCreative{{
+				CreativeExternalID: "creative1",
+				Platforms: []InvoiceCreativePlatform{
+					platform("600.00", "120.00", "720.00"),
+					platform("400", "80", "480"),
+				},
+			}},
+		}},
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.ClientRole = "buyer"
+	invalid.Amount.Services = InvoiceAmountGroup{ExcludingVat: "1000.00", VatRate: "20", Vat: "150.00", IncludingVat: "1200.00"}
+	invalid.Items = []InvoiceItem{{
+		Amount: InvoiceAmountGroup{ExcludingVat: "900.00", VatRate: InvoiceVatRateWithoutVat, Vat: "0", IncludingVat: "900.00"},
+		Creatives: []InvoiceCreative{{
+			Platforms: []InvoiceCreativePlatform{func() InvoiceCreativePlatform {
+				p := platform("750.00", "150.00", "900.00")
+				p.Amount.VatRate = InvoiceVatRateWithoutVat
+				p.DateEndActual = "2023-02-15"
+				p.PayType = "cpv"
+				return p
+			}()},
+		}},
+	}}
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, invalid.Validate(), &verrs)
+	for _, field := range []string{
+		"client_role",
+		"amount.services.vat",
+		"amount.services.including_vat",
+		"amount.services.excluding_vat",
+		"items[0].amount.excluding_vat",
+		"items[0].creatives[0].platforms[0].amount.vat",
+		"items[0].creatives[0].platforms[0].date_end_actual",
+		"items[0].creatives[0].platforms[0].pay_type",
+	} {
+		assert.True(t, verrs.Has(field), field)
+	}
+	assert.False(t, verrs.Has("contractor_role"))
+	assert.False(t, verrs.Has("items[0].creatives[0].platforms[0].date_start_actual"))
+}
+
+func TestVatRange(t *testing.T) {
+	tests := []struct {
+		base   string
+		rate   string
+		vat    string
+		expect bool
+	}{
+		{"1000.00", "20", "200.00", true},
+		{"1000.00", "20", "200.50", true},
+		{"1000.00", "20", "200.51", false},
+		{"1000.00", "20", "199.99", false},
+		{"100.33", "20", "20.06", true},
+		{"1.00", "20", "0.25", true},
+		{"1.00", "20", "0.26", false},
+		{"0.10", "20", "0.02", true},
+		{"0.10", "20", "0.05", true},
+		{"0.10", "20", "0.06", false},
+		{"0.00001", "20", "0.00000", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.base+"/"+tt.vat, func(t *testing.T) {
+			including := MustParseMoney(tt.base).Add(MustParseMoney(tt.vat))
+
+			v := &validator{}
+			InvoiceAmountGroup{
+				ExcludingVat: tt.base,
+				VatRate:      tt.rate,
+				Vat:          tt.vat,
+				IncludingVat: including.String(),
+			}.validate(v, "amount")
+			assert.Equal(t, tt.expect, v.err() == nil, v.errs)
+		})
+	}
+}
+
+func TestClient_WithInvoiceGuard(t *testing.T) {
+	var writes []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			writes = append(writes, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v4/invoice/blocked":
+			fmt.Fprint(w, `{"contract_external_id":"contract1","erir_tax_status":"blocked"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"Not found"}`)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithBase(server.URL), WithToken("test-token"), WithInvoiceGuard())
+	ctx := context.Background()
+
+	assert.ErrorIs(t, client.CreateWholeInvoice(ctx, "blocked", Invoice{}), ErrInvoiceBlocked)
+	assert.ErrorIs(t, client.CreateInvoiceHeaderDraft(ctx, "blocked", Invoice{}), ErrInvoiceBlocked)
+	assert.ErrorIs(t, client.AddContractsToInvoice(ctx, "blocked", nil), ErrInvoiceBlocked)
+	assert.ErrorIs(t, client.DeleteContractsFromInvoice(ctx, "blocked", DeleteContractsFromInvoiceRequest{}), ErrInvoiceBlocked)
+	assert.ErrorIs(t, client.DeleteInvoice(ctx, "blocked"), ErrInvoiceBlocked)
+	assert.Empty(t, writes)
+
+	require.NoError(t, client.CreateWholeInvoice(ctx, "new", Invoice{}))
+	assert.Equal(t, []string{"PUT /v4/invoice/new"}, writes)
+}
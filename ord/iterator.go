@@ -0,0 +1,131 @@
+package ord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// DefaultPageSize is the page size used by All* iterators unless WithPageSize is set
+const DefaultPageSize = 100
+
+// ErrListChanged is returned by All* iterators when the total count of items changes during iteration
+var ErrListChanged = errors.New("list changed during iteration")
+
+// Iterate pages through any list endpoint, it stops on context cancellation
+// and returns ErrListChanged if the total count of items changes between pages
+func Iterate[T any](ctx context.Context, pageSize int, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		offset, total := 0, -1
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := fetch(ctx, offset, pageSize)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			if total >= 0 && page.TotalItemsCount != total {
+				yield(zero, fmt.Errorf("%w: total items count %d -> %d", ErrListChanged, total, page.TotalItemsCount))
+				return
+			}
+			total = page.TotalItemsCount
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !page.HasNext() {
+				return
+			}
+			offset = page.NextOffset()
+		}
+	}
+}
+
+func (c *Client) pageSizeOrDefault() int {
+	if c.pageSize > 0 {
+		return c.pageSize
+	}
+
+	return DefaultPageSize
+}
+
+// AllPersons iterates over external IDs of all persons
+func (c *Client) AllPersons(ctx context.Context) iter.Seq2[string, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListPersons)
+}
+
+// AllContracts iterates over external IDs of all contracts
+func (c *Client) AllContracts(ctx context.Context) iter.Seq2[string, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListContracts)
+}
+
+// AllPads iterates over external IDs of all pads, personExternalID is optional
+func (c *Client) AllPads(ctx context.Context, personExternalID string) iter.Seq2[string, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), func(ctx context.Context, offset, limit int) (*Page[string], error) {
+		return c.ListPads(ctx, offset, limit, personExternalID)
+	})
+}
+
+// AllCreatives iterates over external IDs of all creatives
+func (c *Client) AllCreatives(ctx context.Context) iter.Seq2[string, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListCreatives)
+}
+
+// AllCreativeERIDs iterates over ERIDs of all creatives
+func (c *Client) AllCreativeERIDs(ctx context.Context) iter.Seq2[string, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListCreativeERIDs)
+}
+
+// AllCreativeERIDExternalIDPairs iterates over ERID and external ID pairs of all creatives
+func (c *Client) AllCreativeERIDExternalIDPairs(ctx context.Context) iter.Seq2[CreativeERIDExternalIDPair, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListCreativeERIDExternalIDPairs)
+}
+
+// AllMedia iterates over external IDs of all media files
+func (c *Client) AllMedia(ctx context.Context) iter.Seq2[string, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListMedia)
+}
+
+// AllInvoices iterates over external IDs of all invoices
+func (c *Client) AllInvoices(ctx context.Context) iter.Seq2[string, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListInvoices)
+}
+
+// AllCIDs iterates over all CIDs
+func (c *Client) AllCIDs(ctx context.Context) iter.Seq2[string, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListCIDs)
+}
+
+// AllStatistics iterates over all statistics items
+func (c *Client) AllStatistics(ctx context.Context) iter.Seq2[StatisticsV2Item, error] {
+	return Iterate(ctx, c.pageSizeOrDefault(), c.ListStatistics)
+}
+
+// AllStatisticsFiltered iterates over statistics items matching the filter, long lists
+// of IDs are split into several requests
+func (c *Client) AllStatisticsFiltered(ctx context.Context, filter StatisticsFilter) iter.Seq2[StatisticsV2Item, error] {
+	return func(yield func(StatisticsV2Item, error) bool) {
+		for _, chunk := range filter.Chunks() {
+			items := Iterate(ctx, c.pageSizeOrDefault(), func(ctx context.Context, offset, limit int) (*Page[StatisticsV2Item], error) {
+				return c.ListStatisticsFiltered(ctx, chunk, offset, limit)
+			})
+
+			for item, err := range items {
+				if !yield(item, err) || err != nil {
+					return
+				}
+			}
+		}
+	}
+}
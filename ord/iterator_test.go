@@ -0,0 +1,262 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listServer serves ids as a paginated list, total may be changed by the test between pages
+func listServer(field string, ids []string, total *atomic.Int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		end := min(offset+limit, len(ids))
+		page := []string{}
+		if offset < end {
+			page = ids[offset:end]
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			field:               page,
+			"total_items_count": total.Load(),
+			"limit":             limit,
+		})
+	}))
+}
+
+func TestClient_AllPersons(t *testing.T) {
+	var ids []string
+	for i := 0; i < 7; i++ {
+		ids = append(ids, fmt.Sprintf("person%d", i))
+	}
+	var total atomic.Int64
+	total.Store(int64(len(ids)))
+
+	server := listServer("external_ids", ids, &total)
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithPageSize(3),
+	)
+
+	var got []string
+	for id, err := range client.AllPersons(context.Background()) {
+		require.NoError(t, err)
+		got = append(got, id)
+	}
+
+	assert.Equal(t, ids, got)
+}
+
+func TestClient_AllCIDs_Break(t *testing.T) {
+	ids := []string{"cid1", "cid2", "cid3", "cid4"}
+	var total atomic.Int64
+	total.Store(int64(len(ids)))
+
+	server := listServer("cids", ids, &total)
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithPageSize(2),
+	)
+
+	var got []string
+	for id, err := range client.AllCIDs(context.Background()) {
+		require.NoError(t, err)
+		got = append(got, id)
+		if len(got) == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"cid1", "cid2", "cid3"}, got)
+}
+
+func TestClient_AllContracts_ListChanged(t *testing.T) {
+	ids := []string{"contract1", "contract2", "contract3", "contract4"}
+	var total atomic.Int64
+	total.Store(int64(len(ids)))
+
+	server := listServer("external_ids", ids, &total)
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithPageSize(2),
+	)
+
+	var got []string
+	var iterErr error
+	for id, err := range client.AllContracts(context.Background()) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		got = append(got, id)
+		total.Store(5)
+	}
+
+	assert.ErrorIs(t, iterErr, ErrListChanged)
+	assert.Equal(t, []string{"contract1", "contract2"}, got)
+}
+
+func TestClient_AllInvoices_ContextCanceled(t *testing.T) {
+	ids := []string{"invoice1", "invoice2", "invoice3"}
+	var total atomic.Int64
+	total.Store(int64(len(ids)))
+
+	server := listServer("external_ids", ids, &total)
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithPageSize(1),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []string
+	var iterErr error
+	for id, err := range client.AllInvoices(ctx) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		got = append(got, id)
+		cancel()
+	}
+
+	assert.ErrorIs(t, iterErr, context.Canceled)
+	assert.Equal(t, []string{"invoice1"}, got)
+}
+
+func TestClient_AllStatistics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/statistics/list", r.URL.Path)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		json.NewEncoder(w).Encode(StatisticsListResponse{
+			Items:           []StatisticsV2Item{{CreativeExternalID: fmt.Sprintf("creative%d", offset)}},
+			TotalItemsCount: 2,
+			Limit:           1,
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithPageSize(1),
+	)
+
+	var got []string
+	for item, err := range client.AllStatistics(context.Background()) {
+		require.NoError(t, err)
+		got = append(got, item.CreativeExternalID)
+	}
+
+	assert.Equal(t, []string{"creative0", "creative1"}, got)
+}
+
+func TestClient_AllPads_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+	)
+
+	count := 0
+	for _, err := range client.AllPads(context.Background(), "person1") {
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		count++
+	}
+
+	assert.Equal(t, 1, count)
+}
+
+func TestWithPageSize(t *testing.T) {
+	client, err := NewClient()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPageSize, client.pageSizeOrDefault())
+
+	err = WithPageSize(500)(client)
+	require.NoError(t, err)
+	assert.Equal(t, 500, client.pageSizeOrDefault())
+
+	_, err = NewClient(WithPageSize(0))
+	require.Error(t, err)
+
+	_, err = NewClient(WithPageSize(1001))
+	require.Error(t, err)
+}
+
+func TestClient_AllStatisticsFiltered(t *testing.T) {
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "2024-03-01,2024-04-01", r.URL.Query().Get("months"))
+		assert.Equal(t, "pad1", r.URL.Query().Get("pad_external_ids"))
+
+		creatives := strings.Split(r.URL.Query().Get("creative_external_ids"), ",")
+		assert.LessOrEqual(t, len(r.URL.Query().Get("creative_external_ids")), statisticsFilterMaxLength)
+
+		items := make([]StatisticsV2Item, 0, len(creatives))
+		for _, id := range creatives {
+			items = append(items, StatisticsV2Item{CreativeExternalID: id, PadExternalID: "pad1"})
+		}
+		json.NewEncoder(w).Encode(StatisticsListResponse{Items: items, TotalItemsCount: len(items), Limit: 1000})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithPageSize(1000),
+	)
+
+	creatives := make([]string, 300)
+	for i := range creatives {
+		creatives[i] = fmt.Sprintf("creative-%03d", i)
+	}
+
+	filter := StatisticsFilter{
+		Months:              []Month{NewMonth(2024, time.March), NewMonth(2024, time.April)},
+		CreativeExternalIDs: creatives,
+		PadExternalIDs:      []string{"pad1"},
+	}
+
+	var got []string
+	for item, err := range client.AllStatisticsFiltered(context.Background(), filter) {
+		require.NoError(t, err)
+		got = append(got, item.CreativeExternalID)
+	}
+
+	assert.Equal(t, creatives, got)
+	assert.Greater(t, requests.Load(), int64(1), "long ID list should be split")
+	assert.Equal(t, int64(len(filter.Chunks())), requests.Load())
+}
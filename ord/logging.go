@@ -0,0 +1,110 @@
+package ord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+)
+
+const redacted = "***"
+
+// redactedFields contains JSON fields with personal data which are never logged
+var redactedFields = map[string]bool{
+	"inn":                                true,
+	"kpp":                                true,
+	"phone":                              true,
+	"foreign_inn":                        true,
+	"foreign_registration_number":        true,
+	"client_inn":                         true,
+	"client_phone":                       true,
+	"client_foreign_inn":                 true,
+	"client_foreign_registration_number": true,
+	"foreign_epayment_method":            true,
+	"client_foreign_epayment_method":     true,
+}
+
+// individualTypes are person types whose name is the full name of a person
+var individualTypes = map[string]bool{
+	string(PersonTypePhysical):        true,
+	string(PersonTypeIP):              true,
+	string(PersonTypeForeignPhysical): true,
+}
+
+// RedactJSON replaces values of personal data fields (inn, phone, client_inn, client_phone, ...)
+// in the JSON document, names of persons are replaced when juridical_details.type is an individual.
+// Non-JSON data is returned as a short placeholder
+func RedactJSON(data []byte) []byte {
+	if len(data) == 0 {
+		return data
+	}
+
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return []byte("<non-json body>")
+	}
+
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return []byte("<non-json body>")
+	}
+
+	return redacted
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if details, ok := value["juridical_details"].(map[string]interface{}); ok {
+			if t, ok := details["type"].(string); ok && individualTypes[t] && value["name"] != nil {
+				value["name"] = redacted
+			}
+		}
+
+		for k, item := range value {
+			if redactedFields[strings.ToLower(k)] && item != nil {
+				value[k] = redacted
+				continue
+			}
+			value[k] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+	}
+
+	return v
+}
+
+// logRequest writes request and response at debug level with personal data redacted
+func (c *Client) logRequest(ctx context.Context, method, path, contentType string, body []byte, statusCode int, respBody []byte, err error) {
+	if !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("path", path),
+		slog.String("authorization", "Bearer "+redacted),
+	}
+
+	if len(body) > 0 {
+		if strings.HasPrefix(contentType, "application/json") {
+			attrs = append(attrs, slog.String("request", string(RedactJSON(body))))
+		} else {
+			attrs = append(attrs, slog.Int("request_size", len(body)))
+		}
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	} else {
+		attrs = append(attrs, slog.Int("status", statusCode), slog.String("response", string(RedactJSON(respBody))))
+	}
+
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "ord request", attrs...)
+}
@@ -0,0 +1,114 @@
+//nolint:errcheck
+package ord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Logger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(CID{CID: "cid1", ClientINN: StringPtr("7707083893"), ClientPhone: StringPtr("+79990000000")})
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("secret-token"),
+		WithLogger(logger),
+	)
+
+	person := Person{
+		Name: "Иванов Иван Иванович",
+		JuridicalDetails: JuridicalDetails{
+			Type:  PersonTypePhysical,
+			INN:   "910810615691",
+			Phone: StringPtr("+79991234567"),
+		},
+	}
+	_, err := client.CreatePerson(context.Background(), "person1", person)
+	require.NoError(t, err)
+
+	_, err = client.GetCID(context.Background(), "cid1")
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "ord request")
+	assert.Contains(t, out, "/v1/person/person1")
+	assert.Contains(t, out, `\"name\":\"***\"`)
+	assert.NotContains(t, out, "Иванов")
+	assert.NotContains(t, out, "secret-token")
+	assert.NotContains(t, out, "910810615691")
+	assert.NotContains(t, out, "+79991234567")
+	assert.NotContains(t, out, "7707083893")
+	assert.NotContains(t, out, "+79990000000")
+}
+
+func TestClient_Logger_DebugDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithLogger(logger),
+	)
+
+	_, err := client.CreatePad(context.Background(), "pad1", Pad{})
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestRedactJSON(t *testing.T) {
+	data := []byte(`{"name":"test","juridical_details":{"inn":"7707083893","phone":"+79990000000","kpp":null},"items":[{"client_inn":"123","amount":100.50}]}`)
+
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal(RedactJSON(data), &result))
+
+	details := result["juridical_details"].(map[string]interface{})
+	assert.Equal(t, "test", result["name"])
+	assert.Equal(t, "***", details["inn"])
+	assert.Equal(t, "***", details["phone"])
+	assert.Nil(t, details["kpp"])
+	assert.Equal(t, "***", result["items"].([]interface{})[0].(map[string]interface{})["client_inn"])
+	assert.Equal(t, 100.5, result["items"].([]interface{})[0].(map[string]interface{})["amount"])
+
+	person := []byte(`{"name":"Иванов Иван Иванович","juridical_details":{"type":"foreign_physical","foreign_epayment_method":"4000123456789010"}}`)
+	require.NoError(t, json.Unmarshal(RedactJSON(person), &result))
+	assert.Equal(t, "***", result["name"])
+	assert.Equal(t, "***", result["juridical_details"].(map[string]interface{})["foreign_epayment_method"])
+
+	cid := []byte(`{"name":"cid","client_foreign_epayment_method":"4000123456789010"}`)
+	require.NoError(t, json.Unmarshal(RedactJSON(cid), &result))
+	assert.Equal(t, "cid", result["name"])
+	assert.Equal(t, "***", result["client_foreign_epayment_method"])
+
+	assert.Equal(t, "<non-json body>", string(RedactJSON([]byte("binary"))))
+	assert.Empty(t, RedactJSON(nil))
+}
+
+func TestWithLogger(t *testing.T) {
+	client, err := NewClient()
+	require.NoError(t, err)
+
+	logger := slog.New(slog.DiscardHandler)
+	err = WithLogger(logger)(client)
+	require.NoError(t, err)
+
+	assert.Equal(t, logger, client.logger)
+}
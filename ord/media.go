@@ -3,12 +3,9 @@ package ord
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"mime/multipart"
-	"net/http"
 	"net/url"
 )
 
@@ -32,7 +29,7 @@ func (c *Client) GetMediaList(ctx context.Context, offset, limit int) (*MediaLis
 	path := fmt.Sprintf("/v1/media?offset=%d&limit=%d", offset, limit)
 
 	var response MediaListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetMediaList", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get media list: %w", err)
 	}
 
@@ -58,43 +55,21 @@ func (c *Client) UploadMedia(ctx context.Context, externalID string, filename st
 		return nil, fmt.Errorf("failed to close writer: %w", err)
 	}
 
-	url := c.base + path
-
-	req, err := http.NewRequestWithContext(ctx, "PUT", url, &b)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", w.FormDataContentType())
-
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
-	}
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to perform request: %w", err)
-	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Println("failed to close response body", err)
-		}
-	}()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
-	}
-
 	var result struct {
 		SHA256 string `json:"sha256"`
 	}
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+
+	call := &Call{
+		Operation:   "UploadMedia",
+		Method:      "PUT",
+		Path:        path,
+		ExternalID:  externalID,
+		Body:        b.Bytes(),
+		ContentType: w.FormDataContentType(),
+		Result:      &result,
+	}
+	if err := c.call(ctx, call); err != nil {
+		return nil, err
 	}
 
 	return &result.SHA256, nil
@@ -103,44 +78,27 @@ func (c *Client) UploadMedia(ctx context.Context, externalID string, filename st
 func (c *Client) GetMediaBinary(ctx context.Context, externalID string) ([]byte, error) {
 	path := fmt.Sprintf("/v1/media/%s", url.PathEscape(externalID))
 
-	url := c.base + path
+	var data []byte
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	call := &Call{
+		Operation:  "GetMediaBinary",
+		Method:     "GET",
+		Path:       path,
+		ExternalID: externalID,
+		Result:     &data,
 	}
-
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
-	}
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to perform request: %w", err)
-	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Println("failed to close response body", err)
-		}
-	}()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+	if err := c.call(ctx, call); err != nil {
+		return nil, err
 	}
 
-	return respBody, nil
+	return data, nil
 }
 
 func (c *Client) GetMediaInfo(ctx context.Context, externalID string) (*MediaInfo, error) {
 	path := fmt.Sprintf("/v1/media/%s/info", url.PathEscape(externalID))
 
 	var mediaInfo MediaInfo
-	if err := c.request(ctx, "GET", path, nil, &mediaInfo); err != nil {
+	if err := c.request(ctx, "GetMediaInfo", externalID, "GET", path, nil, &mediaInfo); err != nil {
 		return nil, fmt.Errorf("failed to get media info: %w", err)
 	}
 
@@ -159,7 +117,7 @@ func (c *Client) GetMediaInfoBatch(ctx context.Context, externalIDs []string) ([
 	var response struct {
 		Media []MediaInfo `json:"media"`
 	}
-	if err := c.request(ctx, "POST", path, request, &response); err != nil {
+	if err := c.request(ctx, "GetMediaInfoBatch", "", "POST", path, request, &response); err != nil {
 		return nil, fmt.Errorf("failed to get media info batch: %w", err)
 	}
 
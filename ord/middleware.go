@@ -0,0 +1,29 @@
+package ord
+
+import "context"
+
+// Call describes a single call of a client method passed through the middleware chain
+type Call struct {
+	Operation   string      // имя метода клиента, например CreateContract.
+	Method      string      // HTTP-метод.
+	Path        string      // путь запроса вместе с query-параметрами.
+	ExternalID  string      // внешний идентификатор объекта, если метод с ним работает.
+	Body        interface{} // тело запроса до сериализации, []byte для медиафайлов.
+	ContentType string      // тип содержимого для тела в виде []byte.
+	Result      interface{} // указатель на результат, заполняется после вызова next.
+}
+
+// Handler executes the call
+type Handler func(ctx context.Context, call *Call) error
+
+// Middleware wraps the handler, for example to add logging, metrics or fault injection
+type Middleware func(next Handler) Handler
+
+// chain wraps the handler with middlewares, the first middleware is the outermost one
+func chain(h Handler, middlewares []Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+
+	return h
+}
@@ -0,0 +1,125 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Middleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v1/contract/contract1":
+			json.NewEncoder(w).Encode(Contract{Type: ContractTypeService})
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/v1/media/"):
+			handleUploadMedia(w, r)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	var order []string
+	var calls []Call
+
+	outer := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			order = append(order, "outer")
+			err := next(ctx, call)
+			calls = append(calls, *call)
+			return err
+		}
+	}
+	inner := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			order = append(order, "inner")
+			return next(ctx, call)
+		}
+	}
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithMiddleware(outer, inner),
+	)
+
+	contract, err := client.GetContract(context.Background(), "contract1")
+	require.NoError(t, err)
+	assert.Equal(t, ContractTypeService, contract.Type)
+	assert.Equal(t, []string{"outer", "inner"}, order)
+
+	require.Len(t, calls, 1)
+	assert.Equal(t, "GetContract", calls[0].Operation)
+	assert.Equal(t, "GET", calls[0].Method)
+	assert.Equal(t, "/v1/contract/contract1", calls[0].Path)
+	assert.Equal(t, "contract1", calls[0].ExternalID)
+	assert.Equal(t, ContractTypeService, calls[0].Result.(*Contract).Type)
+
+	person := Person{Name: "test"}
+	_, err = client.CreatePerson(context.Background(), "person1", person)
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+	assert.Equal(t, "CreatePerson", calls[1].Operation)
+	assert.Equal(t, person, calls[1].Body)
+
+	_, err = client.UploadMedia(context.Background(), "media1", "test.txt", strings.NewReader("test file content"))
+	require.NoError(t, err)
+	require.Len(t, calls, 3)
+	assert.Equal(t, "UploadMedia", calls[2].Operation)
+	assert.Equal(t, "media1", calls[2].ExternalID)
+	assert.Contains(t, calls[2].ContentType, "multipart/form-data")
+}
+
+func TestClient_Middleware_FaultInjection(t *testing.T) {
+	var hits atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	injected := errors.New("injected")
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) error {
+				if call.Operation == "DeleteInvoice" {
+					return injected
+				}
+				return next(ctx, call)
+			}
+		}),
+	)
+
+	err := client.DeleteInvoice(context.Background(), "invoice1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, injected)
+	assert.Equal(t, int32(0), hits.Load())
+
+	_, err = client.GetMediaBinary(context.Background(), "media1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), hits.Load())
+}
+
+func TestWithMiddleware(t *testing.T) {
+	client, err := NewClient()
+	require.NoError(t, err)
+
+	option := WithMiddleware(func(next Handler) Handler { return next })
+	err = option(client)
+	require.NoError(t, err)
+
+	assert.Len(t, client.middlewares, 1)
+}
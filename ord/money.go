@@ -0,0 +1,319 @@
+package ord
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// MoneyMaxScale is the maximum number of fractional digits the API accepts in amounts
+const MoneyMaxScale = 8
+
+const (
+	invoiceAmountScale    = 2 // знаков после запятой в суммах актов.
+	statisticsAmountScale = 5 // знаков после запятой в суммах статистики.
+)
+
+var moneyRe = regexp.MustCompile(`^-?\d+(\.\d{1,8})?$`)
+
+// Money is an exact decimal amount in rubles with up to 8 fractional digits,
+// it is marshalled to JSON as a string like "1234.50"
+type Money struct {
+	value *big.Int // значение без запятой, 1234.50 хранится как 123450.
+	scale int      // количество знаков после запятой.
+}
+
+// ParseMoney parses a decimal string like "1234.5"
+func ParseMoney(s string) (Money, error) {
+	if !moneyRe.MatchString(s) {
+		return Money{}, fmt.Errorf("invalid money value: %q", s)
+	}
+
+	integer, frac, _ := strings.Cut(s, ".")
+	value, _ := new(big.Int).SetString(integer+frac, 10)
+
+	return Money{value: value, scale: len(frac)}, nil
+}
+
+// MustParseMoney is like ParseMoney but panics on invalid input, use it for constants
+func MustParseMoney(s string) Money {
+	m, err := ParseMoney(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// NewMoney returns value * 10^-scale, e.g. NewMoney(12345, 2) is 123.45
+func NewMoney(value int64, scale int) Money {
+	if scale < 0 || scale > MoneyMaxScale {
+		panic(fmt.Sprintf("invalid money scale: %d", scale))
+	}
+
+	return Money{value: big.NewInt(value), scale: scale}
+}
+
+func (m Money) int() *big.Int {
+	if m.value == nil {
+		return new(big.Int)
+	}
+
+	return m.value
+}
+
+// rescale returns the unscaled value for a bigger scale
+func (m Money) rescale(scale int) *big.Int {
+	return new(big.Int).Mul(m.int(), pow10(scale-m.scale))
+}
+
+// Scale returns the number of fractional digits
+func (m Money) Scale() int {
+	return m.scale
+}
+
+// Add returns m + o
+func (m Money) Add(o Money) Money {
+	scale := max(m.scale, o.scale)
+	return Money{value: new(big.Int).Add(m.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// Sub returns m - o
+func (m Money) Sub(o Money) Money {
+	scale := max(m.scale, o.scale)
+	return Money{value: new(big.Int).Sub(m.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+// Mul returns m * o rounded half up to MoneyMaxScale digits
+func (m Money) Mul(o Money) Money {
+	return moneyFromRat(new(big.Rat).Mul(m.Rat(), o.Rat()), MoneyMaxScale).trim(max(m.scale, o.scale))
+}
+
+// Percent returns rate percent of m rounded half up to MoneyMaxScale digits
+func (m Money) Percent(rate Money) Money {
+	r := new(big.Rat).Mul(m.Rat(), rate.Rat())
+	r.Quo(r, big.NewRat(100, 1))
+
+	return moneyFromRat(r, MoneyMaxScale).trim(m.scale)
+}
+
+// Round rounds m half away from zero to scale fractional digits, a bigger scale pads zeros
+func (m Money) Round(scale int) Money {
+	if scale >= m.scale {
+		return Money{value: m.rescale(scale), scale: scale}
+	}
+
+	return moneyFromRat(m.Rat(), scale)
+}
+
+// Floor rounds m down to scale fractional digits
+func (m Money) Floor(scale int) Money {
+	if scale >= m.scale {
+		return Money{value: m.rescale(scale), scale: scale}
+	}
+
+	return Money{value: new(big.Int).Div(m.int(), pow10(m.scale-scale)), scale: scale}
+}
+
+// Cmp compares m and o and returns -1, 0 or +1
+func (m Money) Cmp(o Money) int {
+	scale := max(m.scale, o.scale)
+	return m.rescale(scale).Cmp(o.rescale(scale))
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of m
+func (m Money) Sign() int {
+	return m.int().Sign()
+}
+
+// IsZero reports whether m is zero
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Rat returns m as an exact rational number
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(m.int(), pow10(m.scale))
+}
+
+// String formats m with its scale, e.g. "1234.50"
+func (m Money) String() string {
+	digits := new(big.Int).Abs(m.int()).String()
+	if m.scale > 0 {
+		if len(digits) <= m.scale {
+			digits = strings.Repeat("0", m.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-m.scale] + "." + digits[len(digits)-m.scale:]
+	}
+
+	if m.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON accepts both "1234.5" and 1234.5
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	s := string(data)
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	parsed, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+// trim removes trailing zeros but keeps at least scale fractional digits
+func (m Money) trim(scale int) Money {
+	ten := big.NewInt(10)
+	value := new(big.Int).Set(m.int())
+	rem := new(big.Int)
+
+	for m.scale > scale {
+		q, r := new(big.Int).QuoRem(value, ten, rem)
+		if r.Sign() != 0 {
+			break
+		}
+		value = q
+		m.scale--
+	}
+
+	return Money{value: value, scale: m.scale}
+}
+
+// moneyFromRat rounds r half away from zero to scale fractional digits
+func moneyFromRat(r *big.Rat, scale int) Money {
+	n := new(big.Int).Mul(r.Num(), pow10(scale))
+	n.Mul(n, big.NewInt(2))
+	if n.Sign() >= 0 {
+		n.Add(n, r.Denom())
+	} else {
+		n.Sub(n, r.Denom())
+	}
+	n.Quo(n, new(big.Int).Mul(r.Denom(), big.NewInt(2)))
+
+	return Money{value: n, scale: scale}
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// InvoiceAmountFromNet builds an invoice amount group from the amount without VAT,
+// vatRate is a percent like "20" or InvoiceVatRateWithoutVat
+func InvoiceAmountFromNet(net Money, vatRate string) (InvoiceAmountGroup, error) {
+	excluding, vat, including, err := splitVat(net, vatRate, false, invoiceAmountScale)
+	if err != nil {
+		return InvoiceAmountGroup{}, err
+	}
+
+	return InvoiceAmountGroup{ExcludingVat: excluding.String(), VatRate: vatRate, Vat: vat.String(), IncludingVat: including.String()}, nil
+}
+
+// InvoiceAmountFromGross builds an invoice amount group from the amount including VAT
+func InvoiceAmountFromGross(gross Money, vatRate string) (InvoiceAmountGroup, error) {
+	excluding, vat, including, err := splitVat(gross, vatRate, true, invoiceAmountScale)
+	if err != nil {
+		return InvoiceAmountGroup{}, err
+	}
+
+	return InvoiceAmountGroup{ExcludingVat: excluding.String(), VatRate: vatRate, Vat: vat.String(), IncludingVat: including.String()}, nil
+}
+
+// StatisticsAmountFromNet builds a statistics amount from the amount without VAT
+func StatisticsAmountFromNet(net Money, vatRate string) (StatisticsAmount, error) {
+	excluding, vat, including, err := splitVat(net, vatRate, false, statisticsAmountScale)
+	if err != nil {
+		return StatisticsAmount{}, err
+	}
+
+	return StatisticsAmount{ExcludingVAT: excluding.String(), VATRate: vatRate, VAT: vat.String(), IncludingVAT: including.String()}, nil
+}
+
+// StatisticsAmountFromGross builds a statistics amount from the amount including VAT
+func StatisticsAmountFromGross(gross Money, vatRate string) (StatisticsAmount, error) {
+	excluding, vat, including, err := splitVat(gross, vatRate, true, statisticsAmountScale)
+	if err != nil {
+		return StatisticsAmount{}, err
+	}
+
+	return StatisticsAmount{ExcludingVAT: excluding.String(), VATRate: vatRate, VAT: vat.String(), IncludingVAT: including.String()}, nil
+}
+
+// StatisticsV3AmountFromNet builds a v3 statistics amount from the amount without VAT rounded to 5 digits
+func StatisticsV3AmountFromNet(net Money, vatRate StatisticsVatRate) (StatisticsV3Amount, error) {
+	if !vatRate.Valid() {
+		return StatisticsV3Amount{}, fmt.Errorf("invalid vat rate: %q", vatRate)
+	}
+
+	excluding, vat, including, err := splitVat(net, vatRate.String(), false, statisticsAmountScale)
+	if err != nil {
+		return StatisticsV3Amount{}, err
+	}
+
+	return StatisticsV3Amount{ExcludingVAT: excluding, VATRate: vatRate, VAT: vat, IncludingVAT: including}, nil
+}
+
+// StatisticsV3AmountFromGross builds a v3 statistics amount from the amount including VAT rounded to 5 digits
+func StatisticsV3AmountFromGross(gross Money, vatRate StatisticsVatRate) (StatisticsV3Amount, error) {
+	if !vatRate.Valid() {
+		return StatisticsV3Amount{}, fmt.Errorf("invalid vat rate: %q", vatRate)
+	}
+
+	excluding, vat, including, err := splitVat(gross, vatRate.String(), true, statisticsAmountScale)
+	if err != nil {
+		return StatisticsV3Amount{}, err
+	}
+
+	return StatisticsV3Amount{ExcludingVAT: excluding, VATRate: vatRate, VAT: vat, IncludingVAT: including}, nil
+}
+
+// splitVat returns amounts without VAT, VAT and with VAT rounded to scale, gross tells
+// whether the amount includes VAT
+func splitVat(amount Money, vatRate string, gross bool, scale int) (Money, Money, Money, error) {
+	if amount.Sign() < 0 {
+		return Money{}, Money{}, Money{}, fmt.Errorf("negative amount: %s", amount)
+	}
+
+	rate := Money{}
+	if vatRate != InvoiceVatRateWithoutVat {
+		var err error
+		if rate, err = ParseMoney(vatRate); err != nil || rate.Sign() < 0 {
+			return Money{}, Money{}, Money{}, fmt.Errorf("invalid vat rate: %q", vatRate)
+		}
+	}
+
+	amount = amount.Round(scale)
+
+	if !gross {
+		vat := amount.Percent(rate).Round(scale)
+		return amount, vat, amount.Add(vat), nil
+	}
+
+	// net = gross * 100 / (100 + rate)
+	net := new(big.Rat).Mul(amount.Rat(), big.NewRat(100, 1))
+	net.Quo(net, new(big.Rat).Add(big.NewRat(100, 1), rate.Rat()))
+
+	excluding := moneyFromRat(net, scale)
+
+	return excluding, amount.Sub(excluding), amount, nil
+}
@@ -0,0 +1,100 @@
+package ord
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMoney(t *testing.T) {
+	m, err := ParseMoney("1234.50")
+	require.NoError(t, err)
+	assert.Equal(t, "1234.50", m.String())
+	assert.Equal(t, 2, m.Scale())
+
+	for _, s := range []string{"", "1.", ".5", "1e3", "1.123456789", "1,5"} {
+		_, err := ParseMoney(s)
+		assert.Error(t, err, s)
+	}
+
+	assert.Equal(t, "0.05", NewMoney(5, 2).String())
+	assert.Equal(t, "-0.5", NewMoney(-5, 1).String())
+	assert.Equal(t, "0", Money{}.String())
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a := MustParseMoney("0.1")
+	b := MustParseMoney("0.2")
+	assert.Equal(t, "0.3", a.Add(b).String(), "no float rounding errors")
+	assert.Equal(t, 0, a.Add(b).Cmp(MustParseMoney("0.30")))
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+
+	assert.Equal(t, "0.33333333", MustParseMoney("1").Percent(MustParseMoney("33.333333")).String())
+	assert.Equal(t, "200.00", MustParseMoney("1000.00").Percent(MustParseMoney("20")).String())
+
+	m := MustParseMoney("2.345")
+	assert.Equal(t, "2.35", m.Round(2).String())
+	assert.Equal(t, "2.34", m.Floor(2).String())
+	assert.Equal(t, "2.34500", m.Round(5).String())
+	assert.Equal(t, "-2.35", MustParseMoney("-2.345").Round(2).String())
+	assert.Equal(t, "-2.35", MustParseMoney("-2.345").Floor(2).String())
+
+	large := MustParseMoney("999999999999.99999999")
+	assert.Equal(t, "1999999999999.99999998", large.Add(large).String())
+}
+
+func TestMoney_JSON(t *testing.T) {
+	var v struct {
+		Amount Money  `json:"amount"`
+		Other  *Money `json:"other,omitempty"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": "500.50"}`), &v))
+	assert.Equal(t, "500.50", v.Amount.String())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": 12.5}`), &v))
+	assert.Equal(t, "12.5", v.Amount.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount": "abc"}`), &v))
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount": "12.5"}`, string(data))
+}
+
+func TestInvoiceAmountFromNet(t *testing.T) {
+	group, err := InvoiceAmountFromNet(MustParseMoney("1000"), "20")
+	require.NoError(t, err)
+	assert.Equal(t, InvoiceAmountGroup{ExcludingVat: "1000.00", VatRate: "20", Vat: "200.00", IncludingVat: "1200.00"}, group)
+
+	group, err = InvoiceAmountFromNet(MustParseMoney("99.99"), InvoiceVatRateWithoutVat)
+	require.NoError(t, err)
+	assert.Equal(t, "0.00", group.Vat)
+	assert.Equal(t, "99.99", group.IncludingVat)
+
+	_, err = InvoiceAmountFromNet(MustParseMoney("1"), "twenty")
+	assert.Error(t, err)
+}
+
+func TestInvoiceAmountFromGross(t *testing.T) {
+	group, err := InvoiceAmountFromGross(MustParseMoney("100"), "20")
+	require.NoError(t, err)
+	assert.Equal(t, InvoiceAmountGroup{ExcludingVat: "83.33", VatRate: "20", Vat: "16.67", IncludingVat: "100.00"}, group)
+
+	v := &validator{}
+	group.validate(v, "amount")
+	assert.NoError(t, v.err(), "helpers must produce amounts that pass validation")
+}
+
+func TestStatisticsAmountFromNet(t *testing.T) {
+	amount, err := StatisticsAmountFromNet(MustParseMoney("0.123456"), "20")
+	require.NoError(t, err)
+	assert.Equal(t, StatisticsAmount{ExcludingVAT: "0.12346", VATRate: "20", VAT: "0.02469", IncludingVAT: "0.14815"}, amount)
+
+	amount, err = StatisticsAmountFromGross(MustParseMoney("1.2"), "20")
+	require.NoError(t, err)
+	assert.Equal(t, StatisticsAmount{ExcludingVAT: "1.00000", VATRate: "20", VAT: "0.20000", IncludingVAT: "1.20000"}, amount)
+}
@@ -1,12 +1,18 @@
 package ord
 
-import "net/http"
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+)
 
 type Option func(a *Client) error
 
+// WithToken sets a static token, it replaces a token provider set by an earlier option
 func WithToken(token string) Option {
 	return func(c *Client) error {
 		c.token = token
+		c.tokens = nil
 
 		return nil
 	}
@@ -27,3 +33,179 @@ func WithBase(base string) Option {
 		return nil
 	}
 }
+
+// WithRetry enables retries of transient failures (network errors, 429 and 5xx)
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		if policy.MaxAttempts < 1 {
+			return fmt.Errorf("invalid retry max attempts: %d", policy.MaxAttempts)
+		}
+
+		c.retry = &policy
+
+		return nil
+	}
+}
+
+// WithRateLimit limits all requests of the client to rps requests per second with bursts of up to burst requests
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) error {
+		if rps <= 0 {
+			return fmt.Errorf("invalid rate limit: %v", rps)
+		}
+
+		c.limiter = NewRateLimiter(rps, burst)
+
+		return nil
+	}
+}
+
+// WithGroupRateLimit sets an additional limit for the endpoint group, e.g. EndpointGroupStatistics
+func WithGroupRateLimit(group string, rps float64, burst int) Option {
+	return func(c *Client) error {
+		if rps <= 0 {
+			return fmt.Errorf("invalid rate limit for %s: %v", group, rps)
+		}
+
+		if c.groupLimiters == nil {
+			c.groupLimiters = map[string]*RateLimiter{}
+		}
+
+		c.groupLimiters[group] = NewRateLimiter(rps, burst)
+
+		return nil
+	}
+}
+
+// WithMiddleware adds middlewares to the chain, the first added middleware is the outermost one
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, middlewares...)
+
+		return nil
+	}
+}
+
+// WithLogger routes library logging to the logger, requests and responses are logged
+// at debug level with the token and personal data redacted
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) error {
+		if logger == nil {
+			logger = slog.New(slog.DiscardHandler)
+		}
+
+		c.logger = logger
+
+		return nil
+	}
+}
+
+// WithTokenProvider sets the provider used to get the token for each request,
+// it replaces a static token set by an earlier option
+func WithTokenProvider(provider TokenProvider) Option {
+	return func(c *Client) error {
+		c.token = ""
+		c.tokens = provider
+
+		return nil
+	}
+}
+
+// WithEnvironment sets the base URL of the environment, EnvSandbox or EnvProduction
+func WithEnvironment(env Environment) Option {
+	return func(c *Client) error {
+		switch env {
+		case EnvSandbox, EnvProduction:
+		default:
+			return fmt.Errorf("unknown environment: %s", env)
+		}
+
+		c.base = string(env)
+
+		return nil
+	}
+}
+
+// WithProductionGuard makes mutating methods (Create*, Delete*, SendInvoiceToErir, RequestCID, ...)
+// against production fail with ErrProductionWrite unless WithProductionWrites is set
+func WithProductionGuard() Option {
+	return func(c *Client) error {
+		c.productionGuard = true
+
+		return nil
+	}
+}
+
+// WithProductionWrites explicitly allows mutating methods against production when the guard is enabled
+func WithProductionWrites() Option {
+	return func(c *Client) error {
+		c.productionWrites = true
+
+		return nil
+	}
+}
+
+// WithDryRun makes mutating methods validate and record their requests into the recorder
+// instead of sending them, read methods still work normally
+func WithDryRun(recorder Recorder) Option {
+	return func(c *Client) error {
+		c.recorder = recorder
+
+		return nil
+	}
+}
+
+// WithPageSize sets the page size used by All* iterators, the API allows up to 1000
+func WithPageSize(size int) Option {
+	return func(c *Client) error {
+		if size < 1 || size > 1000 {
+			return fmt.Errorf("invalid page size: %d", size)
+		}
+
+		c.pageSize = size
+
+		return nil
+	}
+}
+
+// WithFetchConcurrency sets the number of workers used by Fetch* methods
+func WithFetchConcurrency(workers int) Option {
+	return func(c *Client) error {
+		if workers < 1 {
+			return fmt.Errorf("invalid fetch concurrency: %d", workers)
+		}
+
+		c.fetchConcurrency = workers
+
+		return nil
+	}
+}
+
+// WithValidation makes mutating methods call Validate on the request body before sending it
+func WithValidation() Option {
+	return func(c *Client) error {
+		c.validate = true
+
+		return nil
+	}
+}
+
+// WithStrictInfoMessages makes create methods return ErrUnknownFields when the API reports
+// unknown fields in the request, it helps to catch schema drift in tests
+func WithStrictInfoMessages() Option {
+	return func(c *Client) error {
+		c.strictInfoMessages = true
+
+		return nil
+	}
+}
+
+// WithInvoiceGuard makes methods changing invoices call EnsureInvoiceModifiable first,
+// so blocked invoices are not changed. It costs an extra GET per call
+func WithInvoiceGuard() Option {
+	return func(c *Client) error {
+		c.invoiceGuard = true
+
+		return nil
+	}
+}
@@ -0,0 +1,279 @@
+// Package ordtest provides a record/replay HTTP transport for tests of code built on ord.Client
+package ordtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gohome.4gophers.ru/kovardin/goord/ord"
+)
+
+// Mode selects whether the cassette records real responses or replays saved ones
+type Mode int
+
+const (
+	ModeReplay Mode = iota // ответы берутся из файла, сеть не используется.
+	ModeRecord             // запросы отправляются в API, пары запрос/ответ сохраняются в файл.
+)
+
+// RecordEnv is the environment variable which switches ModeFromEnv to ModeRecord
+const RecordEnv = "ORDTEST_RECORD"
+
+// ErrNoInteraction is returned in replay mode when no recorded interaction matches the request
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// Interaction is a recorded request/response pair
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode  int             `json:"status_code"`
+	ContentType string          `json:"content_type,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	BodyRaw     []byte          `json:"body_raw,omitempty"` // тело, которое не является JSON (например, медиафайл).
+}
+
+// Cassette is an http.RoundTripper which records or replays interactions.
+// Tokens are never saved, personal data in bodies is replaced with ord.RedactJSON
+type Cassette struct {
+	path string
+	mode Mode
+	next http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// ModeFromEnv returns ModeRecord when ORDTEST_RECORD is set to a non-empty value
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnv) != "" {
+		return ModeRecord
+	}
+
+	return ModeReplay
+}
+
+// NewCassette loads the fixture file in replay mode or starts an empty cassette in record mode
+func NewCassette(path string, mode Mode) (*Cassette, error) {
+	c := &Cassette{
+		path: path,
+		mode: mode,
+		next: http.DefaultTransport,
+	}
+
+	if mode == ModeRecord {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cassette: %w", err)
+	}
+
+	c.used = make([]bool, len(c.interactions))
+
+	return c, nil
+}
+
+// SetTransport replaces the transport used to send requests in record mode
+func (c *Cassette) SetTransport(next http.RoundTripper) {
+	c.next = next
+}
+
+// Client returns an HTTP client for ord.WithHttpClient
+func (c *Cassette) Client() *http.Client {
+	return &http.Client{Transport: c}
+}
+
+// Interactions returns a copy of the recorded or loaded interactions
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Interaction(nil), c.interactions...)
+}
+
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		_ = req.Body.Close()
+	}
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+		Body:   scrub(body),
+	}
+
+	if c.mode == ModeReplay {
+		return c.replay(req, recorded)
+	}
+
+	return c.record(req, recorded, body)
+}
+
+// Save writes recorded interactions to the fixture file, it does nothing in replay mode
+func (c *Cassette) Save() error {
+	if c.mode != ModeRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cassette) record(req *http.Request, recorded RecordedRequest, body []byte) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+
+	resp, err := c.next.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	response := RecordedResponse{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if json.Valid(respBody) {
+		response.Body = scrub(respBody)
+	} else {
+		response.BodyRaw = respBody
+	}
+
+	c.mu.Lock()
+	c.interactions = append(c.interactions, Interaction{Request: recorded, Response: response})
+	c.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	match := -1
+	for i, interaction := range c.interactions {
+		if !matches(interaction.Request, recorded) {
+			continue
+		}
+
+		// сначала отдаем неиспользованные ответы по порядку, затем повторяем последний подходящий
+		match = i
+		if !c.used[i] {
+			break
+		}
+	}
+
+	if match < 0 {
+		return nil, fmt.Errorf("%w: %s %s?%s", ErrNoInteraction, recorded.Method, recorded.Path, recorded.Query)
+	}
+
+	c.used[match] = true
+	response := c.interactions[match].Response
+
+	body := []byte(response.Body)
+	if response.BodyRaw != nil {
+		body = response.BodyRaw
+	}
+
+	header := http.Header{}
+	if response.ContentType != "" {
+		header.Set("Content-Type", response.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func matches(recorded, req RecordedRequest) bool {
+	return recorded.Method == req.Method &&
+		recorded.Path == req.Path &&
+		recorded.Query == req.Query &&
+		bytes.Equal(compact(recorded.Body), compact(req.Body))
+}
+
+// compact removes indentation added when the cassette is saved
+func compact(body json.RawMessage) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, body); err != nil {
+		return body
+	}
+
+	return buf.Bytes()
+}
+
+// scrub redacts personal data and normalizes the body so that requests can be compared
+func scrub(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+
+	redacted := ord.RedactJSON(body)
+	if !json.Valid(redacted) {
+		// multipart и другие не-JSON тела сохраняются как строка-заглушка
+		redacted, _ = json.Marshal(strings.TrimSpace(string(redacted)))
+	}
+
+	return redacted
+}
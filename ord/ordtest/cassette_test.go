@@ -0,0 +1,130 @@
+//nolint:errcheck
+package ordtest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gohome.4gophers.ru/kovardin/goord/ord"
+)
+
+func TestCassette_RecordReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v1/person":
+			json.NewEncoder(w).Encode(ord.PersonListResponse{ExternalIDs: []string{"person1"}, TotalItemsCount: 1, Limit: 10})
+		case r.Method == "GET" && r.URL.Path == "/v1/person/person1":
+			json.NewEncoder(w).Encode(ord.Person{
+				Name:             "test",
+				JuridicalDetails: ord.JuridicalDetails{Type: ord.PersonTypeJuridical, INN: "7707083893"},
+			})
+		case r.Method == "PUT" && r.URL.Path == "/v1/person/person1":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "GET" && r.URL.Path == "/v1/media/media1":
+			w.Write([]byte("binary data"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "Not found", "errors": []}`))
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "fixtures", "person.json")
+
+	person := ord.Person{
+		Name:             "test",
+		Roles:            []ord.PersonRole{ord.PersonRoleAdvertiser},
+		JuridicalDetails: ord.JuridicalDetails{Type: ord.PersonTypeJuridical, INN: "7707083893"},
+	}
+
+	run := func(t *testing.T, client *ord.Client) {
+		persons, err := client.GetPersons(context.Background(), 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"person1"}, persons.ExternalIDs)
+
+		result, err := client.GetPerson(context.Background(), "person1")
+		require.NoError(t, err)
+		assert.Equal(t, "test", result.Name)
+
+		_, err = client.CreatePerson(context.Background(), "person1", person)
+		require.NoError(t, err)
+
+		data, err := client.GetMediaBinary(context.Background(), "media1")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("binary data"), data)
+
+		_, err = client.GetPad(context.Background(), "pad1")
+		assert.ErrorIs(t, err, ord.ErrNotFound)
+	}
+
+	t.Run("Record", func(t *testing.T) {
+		cassette, err := NewCassette(path, ModeRecord)
+		require.NoError(t, err)
+
+		client, _ := ord.NewClient(
+			ord.WithBase(server.URL),
+			ord.WithToken("secret-token"),
+			ord.WithHttpClient(cassette.Client()),
+		)
+
+		run(t, client)
+		require.NoError(t, cassette.Save())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "secret-token")
+		assert.NotContains(t, string(data), "7707083893")
+		assert.Len(t, cassette.Interactions(), 5)
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		cassette, err := NewCassette(path, ModeReplay)
+		require.NoError(t, err)
+
+		client, _ := ord.NewClient(
+			ord.WithBase("http://offline.invalid"),
+			ord.WithToken("other-token"),
+			ord.WithHttpClient(cassette.Client()),
+		)
+
+		run(t, client)
+
+		_, err = client.GetPersons(context.Background(), 10, 10)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrNoInteraction)
+
+		other := person
+		other.Name = "changed"
+		_, err = client.CreatePerson(context.Background(), "person1", other)
+		assert.ErrorIs(t, err, ErrNoInteraction)
+	})
+}
+
+func TestNewCassette_Missing(t *testing.T) {
+	_, err := NewCassette(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	require.Error(t, err)
+}
+
+func TestModeFromEnv(t *testing.T) {
+	t.Setenv(RecordEnv, "")
+	assert.Equal(t, ModeReplay, ModeFromEnv())
+
+	t.Setenv(RecordEnv, "1")
+	assert.Equal(t, ModeRecord, ModeFromEnv())
+}
+
+func TestScrub(t *testing.T) {
+	assert.JSONEq(t, `{"client_inn":"***","name":"cid"}`, string(scrub([]byte(`{"name": "cid", "client_inn": "7707083893"}`))))
+	var placeholder string
+	require.NoError(t, json.Unmarshal(scrub([]byte("--boundary")), &placeholder))
+	assert.Equal(t, "<non-json body>", placeholder)
+	assert.Nil(t, scrub(nil))
+}
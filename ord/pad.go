@@ -3,20 +3,42 @@ package ord
 import (
 	"context"
 	"fmt"
+	"slices"
 )
 
+// PadType is the type of an advertising pad
+type PadType string
+
 const (
-	PadTypeWeb       = "web"        // веб-страница, включая мобильные версии сайтов или профили социальной сети.
-	PadTypeMobileApp = "mobile_app" // приложение.
-	PadTypeHbbTV     = "hbbtv"      // приложение HbbTV
-	PadTypeSmartTV   = "smarttv"    // приложение SmartTV
+	PadTypeWeb       PadType = "web"        // веб-страница, включая мобильные версии сайтов или профили социальной сети.
+	PadTypeMobileApp PadType = "mobile_app" // приложение.
+	PadTypeHbbTV     PadType = "hbbtv"      // приложение HbbTV
+	PadTypeSmartTV   PadType = "smarttv"    // приложение SmartTV
 )
 
+// AllPadTypes returns all known pad types
+func AllPadTypes() []PadType {
+	return []PadType{PadTypeWeb, PadTypeMobileApp, PadTypeHbbTV, PadTypeSmartTV}
+}
+
+// ParsePadType returns an error for unknown pad types
+func ParsePadType(s string) (PadType, error) {
+	return parseEnum("pad type", s, AllPadTypes())
+}
+
+func (t PadType) Valid() bool {
+	return slices.Contains(AllPadTypes(), t)
+}
+
+func (t PadType) String() string {
+	return string(t)
+}
+
 type Pad struct {
 	CreateDate       string  `json:"create_date,omitempty"`
 	PersonExternalID string  `json:"person_external_id"`
 	IsOwner          bool    `json:"is_owner"`
-	Type             string  `json:"type"`
+	Type             PadType `json:"type"`
 	Name             string  `json:"name"`
 	URL              *string `json:"url,omitempty"`
 }
@@ -34,7 +56,7 @@ func (c *Client) GetPads(ctx context.Context, offset, limit int, personExternalI
 	}
 
 	var response PadListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetPads", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get pads: %w", err)
 	}
 
@@ -47,7 +69,7 @@ func (c *Client) GetRestrictedPads(ctx context.Context) ([]string, error) {
 	var response struct {
 		URLs []string `json:"urls"`
 	}
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetRestrictedPads", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get restricted pads: %w", err)
 	}
 
@@ -58,19 +80,24 @@ func (c *Client) GetPad(ctx context.Context, externalID string) (*Pad, error) {
 	path := fmt.Sprintf("/v1/pad/%s", externalID)
 
 	var pad Pad
-	if err := c.request(ctx, "GET", path, nil, &pad); err != nil {
+	if err := c.request(ctx, "GetPad", externalID, "GET", path, nil, &pad); err != nil {
 		return nil, fmt.Errorf("failed to get pad: %w", err)
 	}
 
 	return &pad, nil
 }
 
-func (c *Client) CreatePad(ctx context.Context, externalID string, pad Pad) error {
+func (c *Client) CreatePad(ctx context.Context, externalID string, pad Pad) (*PutResult, error) {
 	path := fmt.Sprintf("/v1/pad/%s", externalID)
 
-	if err := c.request(ctx, "PUT", path, pad, nil); err != nil {
-		return fmt.Errorf("failed to create pad: %w", err)
+	var result PutResult
+	if err := c.request(ctx, "CreatePad", externalID, "PUT", path, pad, &result); err != nil {
+		return nil, fmt.Errorf("failed to create pad: %w", err)
+	}
+
+	if err := c.checkInfoMessages(&result); err != nil {
+		return &result, fmt.Errorf("failed to create pad: %w", err)
 	}
 
-	return nil
+	return &result, nil
 }
@@ -232,7 +232,7 @@ func TestClient_CreatePad(t *testing.T) {
 		WithToken("test-token"),
 	)
 
-	err := client.CreatePad(context.Background(), "test-pad", testPad)
+	_, err := client.CreatePad(context.Background(), "test-pad", testPad)
 	require.NoError(t, err, "CreatePad should not return an error")
 }
 
@@ -255,7 +255,7 @@ func TestClient_CreatePad_Error(t *testing.T) {
 		WithToken("test-token"),
 	)
 
-	err := client.CreatePad(context.Background(), "test-pad", testPad)
+	_, err := client.CreatePad(context.Background(), "test-pad", testPad)
 	require.Error(t, err, "CreatePad should return an error")
 
 	assert.Contains(t, err.Error(), "failed to create pad", "Error message should contain expected text")
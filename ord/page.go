@@ -0,0 +1,143 @@
+package ord
+
+import "context"
+
+// Page is a page of any list endpoint
+type Page[T any] struct {
+	Items           []T `json:"items"`
+	TotalItemsCount int `json:"total_items_count"`
+	Limit           int `json:"limit"`
+	Offset          int `json:"offset"`
+}
+
+// PageFunc fetches a page starting at offset
+type PageFunc[T any] func(ctx context.Context, offset, limit int) (*Page[T], error)
+
+// HasNext reports whether there are items after this page
+func (p *Page[T]) HasNext() bool {
+	return len(p.Items) > 0 && p.NextOffset() < p.TotalItemsCount
+}
+
+// NextOffset returns the offset of the next page
+func (p *Page[T]) NextOffset() int {
+	return p.Offset + len(p.Items)
+}
+
+func newPage[T any](items []T, total, limit, offset int) *Page[T] {
+	return &Page[T]{
+		Items:           items,
+		TotalItemsCount: total,
+		Limit:           limit,
+		Offset:          offset,
+	}
+}
+
+// ListPersons returns a page of person external IDs
+func (c *Client) ListPersons(ctx context.Context, offset, limit int) (*Page[string], error) {
+	response, err := c.GetPersons(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.ExternalIDs, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListContracts returns a page of contract external IDs
+func (c *Client) ListContracts(ctx context.Context, offset, limit int) (*Page[string], error) {
+	response, err := c.GetContracts(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.ExternalIDs, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListPads returns a page of pad external IDs, personExternalID is optional
+func (c *Client) ListPads(ctx context.Context, offset, limit int, personExternalID string) (*Page[string], error) {
+	response, err := c.GetPads(ctx, offset, limit, personExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.ExternalIDs, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListCreatives returns a page of creative external IDs
+func (c *Client) ListCreatives(ctx context.Context, offset, limit int) (*Page[string], error) {
+	response, err := c.GetCreatives(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.ExternalIDs, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListCreativeERIDs returns a page of creative ERIDs
+func (c *Client) ListCreativeERIDs(ctx context.Context, offset, limit int) (*Page[string], error) {
+	response, err := c.GetCreativeERIDs(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.ERIDs, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListCreativeERIDExternalIDPairs returns a page of ERID and external ID pairs
+func (c *Client) ListCreativeERIDExternalIDPairs(ctx context.Context, offset, limit int) (*Page[CreativeERIDExternalIDPair], error) {
+	response, err := c.GetCreativeERIDExternalIDPairs(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.Items, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListMedia returns a page of media external IDs
+func (c *Client) ListMedia(ctx context.Context, offset, limit int) (*Page[string], error) {
+	response, err := c.GetMediaList(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.ExternalIDs, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListInvoices returns a page of invoice external IDs
+func (c *Client) ListInvoices(ctx context.Context, offset, limit int) (*Page[string], error) {
+	response, err := c.GetInvoices(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.ExternalIDs, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListCIDs returns a page of CIDs
+func (c *Client) ListCIDs(ctx context.Context, offset, limit int) (*Page[string], error) {
+	response, err := c.GetCIDList(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.CIDs, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListStatistics returns a page of statistics items
+func (c *Client) ListStatistics(ctx context.Context, offset, limit int) (*Page[StatisticsV2Item], error) {
+	response, err := c.GetStatisticsList(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.Items, response.TotalItemsCount, response.Limit, offset), nil
+}
+
+// ListStatisticsFiltered returns a page of statistics items matching the filter
+func (c *Client) ListStatisticsFiltered(ctx context.Context, filter StatisticsFilter, offset, limit int) (*Page[StatisticsV2Item], error) {
+	response, err := c.GetStatisticsListFiltered(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPage(response.Items, response.TotalItemsCount, response.Limit, offset), nil
+}
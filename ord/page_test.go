@@ -0,0 +1,87 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPage(t *testing.T) {
+	page := Page[string]{Items: []string{"a", "b"}, TotalItemsCount: 5, Limit: 2, Offset: 2}
+	assert.True(t, page.HasNext())
+	assert.Equal(t, 4, page.NextOffset())
+
+	page = Page[string]{Items: []string{"e"}, TotalItemsCount: 5, Limit: 2, Offset: 4}
+	assert.False(t, page.HasNext())
+	assert.Equal(t, 5, page.NextOffset())
+
+	page = Page[string]{TotalItemsCount: 5, Limit: 2, Offset: 6}
+	assert.False(t, page.HasNext(), "empty page should stop pagination")
+}
+
+func TestClient_ListPersons(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/person", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("offset"))
+		json.NewEncoder(w).Encode(PersonListResponse{ExternalIDs: []string{"p1", "p2"}, TotalItemsCount: 20, Limit: 2})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+	)
+
+	page, err := client.ListPersons(context.Background(), 10, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p1", "p2"}, page.Items)
+	assert.Equal(t, 20, page.TotalItemsCount)
+	assert.Equal(t, 2, page.Limit)
+	assert.Equal(t, 10, page.Offset)
+	assert.True(t, page.HasNext())
+	assert.Equal(t, 12, page.NextOffset())
+}
+
+func TestClient_ListCreativeERIDExternalIDPairs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(CreativeERIDExternalIDPairsResponse{
+			Items:           []CreativeERIDExternalIDPair{{ERID: "erid1", ExternalID: "creative1"}},
+			TotalItemsCount: 1,
+			Limit:           10,
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+	)
+
+	page, err := client.ListCreativeERIDExternalIDPairs(context.Background(), 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "erid1", page.Items[0].ERID)
+	assert.False(t, page.HasNext())
+}
+
+func TestIterate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	fetch := func(ctx context.Context, offset, limit int) (*Page[int], error) {
+		end := min(offset+limit, len(items))
+		return &Page[int]{Items: items[offset:end], TotalItemsCount: len(items), Limit: limit, Offset: offset}, nil
+	}
+
+	var got []int
+	for item, err := range Iterate(context.Background(), 2, fetch) {
+		require.NoError(t, err)
+		got = append(got, item)
+	}
+
+	assert.Equal(t, items, got)
+}
@@ -3,34 +3,91 @@ package ord
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"unicode/utf8"
 )
 
 type Person struct {
 	CreateDate       string           `json:"create_date,omitempty"`
 	Name             string           `json:"name"`
 	RsURL            *string          `json:"rs_url,omitempty"`
-	Roles            []string         `json:"roles"`
+	Roles            []PersonRole     `json:"roles"`
 	JuridicalDetails JuridicalDetails `json:"juridical_details"`
 	LockedFields     []LockedField    `json:"locked_fields,omitempty"`
 }
 
+// PersonType is the type of juridical details of a person
+type PersonType string
+
+const (
+	PersonTypePhysical         PersonType = "physical"          // физическое лицо.
+	PersonTypeJuridical        PersonType = "juridical"         // юридическое лицо.
+	PersonTypeIP               PersonType = "ip"                // индивидуальный предприниматель.
+	PersonTypeForeignPhysical  PersonType = "foreign_physical"  // иностранное физическое лицо.
+	PersonTypeForeignJuridical PersonType = "foreign_juridical" // иностранное юридическое лицо.
+)
+
+// AllPersonTypes returns all known person types
+func AllPersonTypes() []PersonType {
+	return []PersonType{PersonTypePhysical, PersonTypeJuridical, PersonTypeIP, PersonTypeForeignPhysical, PersonTypeForeignJuridical}
+}
+
+// ParsePersonType returns an error for unknown person types
+func ParsePersonType(s string) (PersonType, error) {
+	return parseEnum("person type", s, AllPersonTypes())
+}
+
+func (t PersonType) Valid() bool {
+	return slices.Contains(AllPersonTypes(), t)
+}
+
+func (t PersonType) String() string {
+	return string(t)
+}
+
+// IsForeign reports whether the type is foreign_physical or foreign_juridical
+func (t PersonType) IsForeign() bool {
+	return t == PersonTypeForeignPhysical || t == PersonTypeForeignJuridical
+}
+
+// PersonRole is a role of a person in the advertising chain
+type PersonRole string
+
 const (
-	PersonTypePhysical         = "physical"          // физическое лицо.
-	PersonTypeJuridical        = "juridical"         // юридическое лицо.
-	PersonTypeIP               = "ip"                // индивидуальный предприниматель.
-	PersonTypeForeignPhysical  = "foreign_physical"  // иностранное физическое лицо.
-	PersonTypeForeignJuridical = "foreign_juridical" // иностранное юридическое лицо.
+	PersonRoleAdvertiser PersonRole = "advertiser" // рекламодатель.
+	PersonRoleAgency     PersonRole = "agency"     // рекламное агентство.
+	PersonRoleOrs        PersonRole = "ors"        // оператор рекламной системы.
+	PersonRolePublisher  PersonRole = "publisher"  // издатель, рекламораспространитель.
 )
 
+// AllPersonRoles returns all known person roles
+func AllPersonRoles() []PersonRole {
+	return []PersonRole{PersonRoleAdvertiser, PersonRoleAgency, PersonRoleOrs, PersonRolePublisher}
+}
+
+// ParsePersonRole returns an error for unknown person roles
+func ParsePersonRole(s string) (PersonRole, error) {
+	return parseEnum("person role", s, AllPersonRoles())
+}
+
+func (r PersonRole) Valid() bool {
+	return slices.Contains(AllPersonRoles(), r)
+}
+
+func (r PersonRole) String() string {
+	return string(r)
+}
+
 type JuridicalDetails struct {
-	Type                      string  `json:"type"`
-	INN                       string  `json:"inn"`
-	KPP                       *string `json:"kpp,omitempty"`
-	Phone                     *string `json:"phone,omitempty"`
-	ForeignEpaymentMethod     *string `json:"foreign_epayment_method,omitempty"`
-	ForeignRegistrationNumber *string `json:"foreign_registration_number,omitempty"`
-	ForeignINN                *string `json:"foreign_inn,omitempty"`
-	ForeignOKSMCountryCode    *string `json:"foreign_oksm_country_code,omitempty"`
+	Type                      PersonType `json:"type"`
+	INN                       string     `json:"inn"`
+	KPP                       *string    `json:"kpp,omitempty"`
+	Phone                     *string    `json:"phone,omitempty"`
+	ForeignEpaymentMethod     *string    `json:"foreign_epayment_method,omitempty"`
+	ForeignRegistrationNumber *string    `json:"foreign_registration_number,omitempty"`
+	ForeignINN                *string    `json:"foreign_inn,omitempty"`
+	ForeignOKSMCountryCode    *string    `json:"foreign_oksm_country_code,omitempty"`
 }
 
 type LockedField struct {
@@ -48,7 +105,7 @@ func (c *Client) GetPersons(ctx context.Context, offset, limit int) (*PersonList
 	path := fmt.Sprintf("/v1/person?offset=%d&limit=%d", offset, limit)
 
 	var response PersonListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetPersons", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get persons: %w", err)
 	}
 
@@ -59,19 +116,155 @@ func (c *Client) GetPerson(ctx context.Context, externalID string) (*Person, err
 	path := fmt.Sprintf("/v1/person/%s", externalID)
 
 	var person Person
-	if err := c.request(ctx, "GET", path, nil, &person); err != nil {
+	if err := c.request(ctx, "GetPerson", externalID, "GET", path, nil, &person); err != nil {
 		return nil, fmt.Errorf("failed to get person: %w", err)
 	}
 
 	return &person, nil
 }
 
-func (c *Client) CreatePerson(ctx context.Context, externalID string, person Person) error {
+func (c *Client) CreatePerson(ctx context.Context, externalID string, person Person) (*PutResult, error) {
 	path := fmt.Sprintf("/v1/person/%s", externalID)
 
-	if err := c.request(ctx, "PUT", path, person, nil); err != nil {
-		return fmt.Errorf("failed to create person: %w", err)
+	var result PutResult
+	if err := c.request(ctx, "CreatePerson", externalID, "PUT", path, person, &result); err != nil {
+		return nil, fmt.Errorf("failed to create person: %w", err)
+	}
+
+	if err := c.checkInfoMessages(&result); err != nil {
+		return &result, fmt.Errorf("failed to create person: %w", err)
+	}
+
+	return &result, nil
+}
+
+var (
+	kppRe   = regexp.MustCompile(`^[0-9]{9}$`)
+	phoneRe = regexp.MustCompile(`^\+[() \-0-9]{8,35}$`)
+	oksmRe  = regexp.MustCompile(`^[0-9]{3}$`)
+)
+
+// Validate checks the person before CreatePerson: INN checksum, KPP and phone format,
+// foreign-only fields and roles. It returns ValidationErrors or nil
+func (p Person) Validate() error {
+	v := &validator{}
+
+	if p.Name == "" {
+		v.add("name", "is required")
+	} else if utf8.RuneCountInString(p.Name) > 255 {
+		v.add("name", "must be at most 255 characters")
+	}
+
+	if len(p.Roles) == 0 {
+		v.add("roles", "at least one role is required")
+	}
+	for _, role := range p.Roles {
+		if !role.Valid() {
+			v.add("roles", "unknown role %q", role)
+		}
+	}
+
+	if slices.Contains(p.Roles, PersonRoleOrs) && (p.RsURL == nil || *p.RsURL == "") {
+		v.add("rs_url", "is required for role ors")
+	}
+
+	p.JuridicalDetails.validate(v, p.Roles)
+
+	return v.err()
+}
+
+func (d JuridicalDetails) validate(v *validator, roles []PersonRole) {
+	switch d.Type {
+	case PersonTypeJuridical:
+		if !validINN(d.INN, 10) {
+			v.add("juridical_details.inn", "must be a valid 10-digit INN")
+		}
+	case PersonTypePhysical, PersonTypeIP:
+		if !validINN(d.INN, 12) {
+			v.add("juridical_details.inn", "must be a valid 12-digit INN")
+		}
+	case PersonTypeForeignPhysical, PersonTypeForeignJuridical:
+		if d.INN != "" {
+			v.add("juridical_details.inn", "is not allowed for type %s", d.Type)
+		}
+	case "":
+		v.add("juridical_details.type", "is required")
+	default:
+		v.add("juridical_details.type", "unknown type %q", d.Type)
+	}
+
+	if d.KPP != nil {
+		if d.Type != PersonTypeJuridical {
+			v.add("juridical_details.kpp", "is allowed only for type %s", PersonTypeJuridical)
+		} else if !kppRe.MatchString(*d.KPP) {
+			v.add("juridical_details.kpp", "must be 9 digits")
+		}
+	}
+
+	if d.Phone != nil && !validPhone(*d.Phone) {
+		v.add("juridical_details.phone", "must start with + and contain 8 to 15 digits")
+	}
+
+	if !d.Type.IsForeign() {
+		foreignFields := []struct {
+			field string
+			value *string
+		}{
+			{"juridical_details.foreign_epayment_method", d.ForeignEpaymentMethod},
+			{"juridical_details.foreign_registration_number", d.ForeignRegistrationNumber},
+			{"juridical_details.foreign_inn", d.ForeignINN},
+			{"juridical_details.foreign_oksm_country_code", d.ForeignOKSMCountryCode},
+		}
+		for _, f := range foreignFields {
+			if f.value != nil {
+				v.add(f.field, "is allowed only for foreign types")
+			}
+		}
+
+		return
+	}
+
+	if d.ForeignOKSMCountryCode == nil {
+		v.add("juridical_details.foreign_oksm_country_code", "is required for type %s", d.Type)
+	} else if !oksmRe.MatchString(*d.ForeignOKSMCountryCode) {
+		v.add("juridical_details.foreign_oksm_country_code", "must be 3 digits")
+	}
+
+	switch d.Type {
+	case PersonTypeForeignPhysical:
+		if d.ForeignRegistrationNumber != nil {
+			v.add("juridical_details.foreign_registration_number", "is allowed only for type %s", PersonTypeForeignJuridical)
+		}
+		if d.Phone == nil && d.ForeignEpaymentMethod == nil {
+			v.add("juridical_details.phone", "phone or foreign_epayment_method is required for type %s", d.Type)
+		}
+		if slices.Contains(roles, PersonRoleOrs) && d.ForeignINN == nil {
+			v.add("juridical_details.foreign_inn", "is required for role ors")
+		}
+		for _, role := range []PersonRole{PersonRoleAdvertiser, PersonRoleAgency, PersonRolePublisher} {
+			if slices.Contains(roles, role) && d.ForeignINN != nil {
+				v.add("juridical_details.foreign_inn", "is not allowed for type %s with role %s", d.Type, role)
+				break
+			}
+		}
+	case PersonTypeForeignJuridical:
+		if d.ForeignRegistrationNumber == nil && d.ForeignINN == nil {
+			v.add("juridical_details.foreign_inn", "foreign_inn or foreign_registration_number is required for type %s", d.Type)
+		}
+	}
+}
+
+func validPhone(phone string) bool {
+	if !phoneRe.MatchString(phone) {
+		return false
+	}
+
+	digits := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
 	}
 
-	return nil
+	return digits >= 8 && digits <= 15
 }
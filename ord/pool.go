@@ -0,0 +1,178 @@
+package ord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ClientPool keeps clients of several ORD cabinets (one token per legal entity).
+// All clients share the HTTP client, retry policy, rate limiters, logger and middlewares of the pool
+type ClientPool struct {
+	base *Client
+
+	mu      sync.RWMutex
+	clients map[string]*Client
+}
+
+// CabinetErirStatusItem is an ERIR status item with the name of the cabinet it belongs to
+type CabinetErirStatusItem struct {
+	Cabinet string `json:"cabinet"`
+	ErirStatusEntityItem
+}
+
+type cabinetKey struct{}
+
+// ContextWithCabinet selects the cabinet used by ClientPool.Client
+func ContextWithCabinet(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, cabinetKey{}, name)
+}
+
+// CabinetFromContext returns the cabinet selected with ContextWithCabinet
+func CabinetFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(cabinetKey{}).(string)
+
+	return name, ok
+}
+
+// NewClientPool creates a pool, options are shared by all cabinets
+func NewClientPool(options ...Option) (*ClientPool, error) {
+	base, err := NewClient(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientPool{
+		base:    base,
+		clients: map[string]*Client{},
+	}, nil
+}
+
+// Add registers a cabinet, options (usually WithToken or WithTokenProvider) are applied on top of the shared ones
+func (p *ClientPool) Add(name string, options ...Option) (*Client, error) {
+	cl := p.base.clone()
+
+	for _, o := range options {
+		if err := o(cl); err != nil {
+			return nil, err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.clients[name]; ok {
+		return nil, fmt.Errorf("cabinet %s already exists", name)
+	}
+
+	p.clients[name] = cl
+
+	return cl, nil
+}
+
+// Remove unregisters the cabinet
+func (p *ClientPool) Remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.clients, name)
+}
+
+// Get returns the client of the cabinet
+func (p *ClientPool) Get(name string) (*Client, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	cl, ok := p.clients[name]
+
+	return cl, ok
+}
+
+// Client returns the client of the cabinet selected with ContextWithCabinet
+func (p *ClientPool) Client(ctx context.Context) (*Client, error) {
+	name, ok := CabinetFromContext(ctx)
+	if !ok {
+		return nil, errors.New("cabinet is not set in context")
+	}
+
+	cl, ok := p.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown cabinet %s", name)
+	}
+
+	return cl, nil
+}
+
+// Names returns sorted names of all cabinets
+func (p *ClientPool) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	names := make([]string, 0, len(p.clients))
+	for name := range p.clients {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// RunAll calls fn concurrently for every cabinet of the pool and returns results keyed by cabinet name.
+// Errors of separate cabinets are joined, results of successful cabinets are returned anyway
+func RunAll[T any](ctx context.Context, p *ClientPool, fn func(ctx context.Context, c *Client) (T, error)) (map[string]T, error) {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		results = map[string]T{}
+		errs    []error
+	)
+
+	for _, name := range p.Names() {
+		cl, ok := p.Get(name)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result, err := fn(ContextWithCabinet(ctx, name), cl)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("cabinet %s: %w", name, err))
+				return
+			}
+			results[name] = result
+		}()
+	}
+	wg.Wait()
+
+	return results, errors.Join(errs...)
+}
+
+// GetErirStatuses requests ERIR statuses in all cabinets and merges the items
+func (p *ClientPool) GetErirStatuses(ctx context.Context, dataType ErirDataType, erirStatus ErirStatus, offset, limit, limitPerEntity int, externalIDs []string) ([]CabinetErirStatusItem, error) {
+	results, err := RunAll(ctx, p, func(ctx context.Context, c *Client) (*ErirStatusEntities, error) {
+		return c.GetErirStatuses(ctx, dataType, erirStatus, offset, limit, limitPerEntity, externalIDs)
+	})
+
+	var items []CabinetErirStatusItem
+	for _, name := range p.Names() {
+		statuses, ok := results[name]
+		if !ok {
+			continue
+		}
+
+		for _, item := range statuses.Items {
+			items = append(items, CabinetErirStatusItem{Cabinet: name, ErirStatusEntityItem: item})
+		}
+	}
+
+	return items, err
+}
@@ -0,0 +1,134 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer token-a":
+			json.NewEncoder(w).Encode(ErirStatusEntities{
+				TotalItemsCount: 1,
+				Items:           []ErirStatusEntityItem{{DataType: "contract", ExternalID: "contract-a"}},
+			})
+		case "Bearer token-b":
+			json.NewEncoder(w).Encode(ErirStatusEntities{
+				TotalItemsCount: 2,
+				Items: []ErirStatusEntityItem{
+					{DataType: "contract", ExternalID: "contract-b1"},
+					{DataType: "contract", ExternalID: "contract-b2"},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	pool, err := NewClientPool(
+		WithBase(server.URL),
+		WithRateLimit(1000, 10),
+	)
+	require.NoError(t, err)
+
+	a, err := pool.Add("a", WithToken("token-a"))
+	require.NoError(t, err)
+	b, err := pool.Add("b", WithToken("token-b"))
+	require.NoError(t, err)
+
+	_, err = pool.Add("a", WithToken("token-c"))
+	require.Error(t, err, "duplicate cabinet should return an error")
+
+	assert.Equal(t, []string{"a", "b"}, pool.Names())
+	assert.Same(t, a.limiter, b.limiter, "rate limiter should be shared")
+	assert.Same(t, a.http, b.http, "HTTP client should be shared")
+
+	t.Run("Client", func(t *testing.T) {
+		cl, err := pool.Client(ContextWithCabinet(context.Background(), "b"))
+		require.NoError(t, err)
+		assert.Same(t, b, cl)
+
+		_, err = pool.Client(context.Background())
+		require.Error(t, err)
+
+		_, err = pool.Client(ContextWithCabinet(context.Background(), "unknown"))
+		require.Error(t, err)
+	})
+
+	t.Run("GetErirStatuses", func(t *testing.T) {
+		items, err := pool.GetErirStatuses(context.Background(), "contract", "", 0, 10, 1, nil)
+		require.NoError(t, err)
+		require.Len(t, items, 3)
+		assert.Equal(t, "a", items[0].Cabinet)
+		assert.Equal(t, "contract-a", items[0].ExternalID)
+		assert.Equal(t, "b", items[1].Cabinet)
+		assert.Equal(t, "contract-b2", items[2].ExternalID)
+	})
+
+	t.Run("RunAll_Error", func(t *testing.T) {
+		_, err := pool.Add("c", WithToken("invalid"))
+		require.NoError(t, err)
+		defer pool.Remove("c")
+
+		results, err := RunAll(context.Background(), pool, func(ctx context.Context, c *Client) (int, error) {
+			statuses, err := c.GetErirStatuses(ctx, "", "", 0, 10, 1, nil)
+			if err != nil {
+				return 0, err
+			}
+			return statuses.TotalItemsCount, nil
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		assert.Contains(t, err.Error(), "cabinet c")
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, results)
+	})
+}
+
+func TestClientPool_GroupRateLimit(t *testing.T) {
+	pool, err := NewClientPool(WithGroupRateLimit(EndpointGroupContract, 100, 10))
+	require.NoError(t, err)
+
+	a, err := pool.Add("a", WithToken("token-a"))
+	require.NoError(t, err)
+	b, err := pool.Add("b", WithToken("token-b"), WithGroupRateLimit(EndpointGroupPerson, 1, 1))
+	require.NoError(t, err)
+
+	assert.Contains(t, b.groupLimiters, EndpointGroupPerson)
+	assert.NotContains(t, a.groupLimiters, EndpointGroupPerson, "per-cabinet limit should not leak to other cabinets")
+	assert.NotContains(t, pool.base.groupLimiters, EndpointGroupPerson, "per-cabinet limit should not leak to the pool base")
+	assert.Same(t, a.groupLimiters[EndpointGroupContract], b.groupLimiters[EndpointGroupContract], "shared group limiters should stay shared")
+}
+
+func TestClientPool_TokenOverridesSharedProvider(t *testing.T) {
+	var got []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		json.NewEncoder(w).Encode(Person{Name: "test"})
+	}))
+	defer server.Close()
+
+	pool, err := NewClientPool(WithBase(server.URL), WithTokenProvider(StaticToken("shared")))
+	require.NoError(t, err)
+
+	a, err := pool.Add("a", WithToken("token-a"))
+	require.NoError(t, err)
+	b, err := pool.Add("b")
+	require.NoError(t, err)
+
+	_, err = a.GetPerson(context.Background(), "person1")
+	require.NoError(t, err)
+	_, err = b.GetPerson(context.Background(), "person1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Bearer token-a", "Bearer shared"}, got, "cabinet token should replace the shared provider")
+}
@@ -0,0 +1,151 @@
+package ord
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Endpoint groups for per-group rate limits
+const (
+	EndpointGroupPerson     = "person"        // контрагенты.
+	EndpointGroupContract   = "contract"      // договоры.
+	EndpointGroupPad        = "pad"           // площадки.
+	EndpointGroupCreative   = "creative"      // креативы.
+	EndpointGroupMedia      = "media"         // медиафайлы.
+	EndpointGroupInvoice    = "invoice"       // акты.
+	EndpointGroupStatistics = "statistics"    // статистика.
+	EndpointGroupCID        = "cid"           // CID.
+	EndpointGroupDictionary = "dict"          // справочники.
+	EndpointGroupErirStatus = "erir_statuses" // статусы обработки в ЕРИР.
+)
+
+// RateLimitStats contains the time spent waiting for the rate limiter
+type RateLimitStats struct {
+	Requests int64         // количество запросов, прошедших через лимитер.
+	Waits    int64         // количество запросов, которым пришлось ждать.
+	WaitTime time.Duration // суммарное время ожидания.
+}
+
+// RateLimiter is a token bucket limiter safe for concurrent use
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	stats  RateLimitStats
+}
+
+// NewRateLimiter creates a limiter allowing rps requests per second with bursts of up to burst requests
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// резервируем токен заранее, чтобы конкурирующие горутины встали в очередь за нами
+	l.tokens--
+	l.stats.Requests++
+
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.stats.Waits++
+	l.mu.Unlock()
+
+	start := time.Now()
+	err := sleep(ctx, wait)
+
+	l.mu.Lock()
+	l.stats.WaitTime += time.Since(start)
+	if err != nil {
+		l.tokens++
+	}
+	l.mu.Unlock()
+
+	return err
+}
+
+// Stats returns the accumulated waiting statistics
+func (l *RateLimiter) Stats() RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.stats
+}
+
+// RateLimitStats returns waiting statistics of the limiter for the endpoint group,
+// an empty group returns statistics of the client-wide limiter
+func (c *Client) RateLimitStats(group string) RateLimitStats {
+	l := c.limiter
+	if group != "" {
+		l = c.groupLimiters[group]
+	}
+
+	if l == nil {
+		return RateLimitStats{}
+	}
+
+	return l.Stats()
+}
+
+// wait blocks on the client-wide limiter and the limiter of the endpoint group
+func (c *Client) wait(ctx context.Context, path string) error {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if l := c.groupLimiters[endpointGroup(path)]; l != nil {
+		if err := l.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// endpointGroup extracts the resource name from the path, e.g. /v3/statistics/list -> statistics,
+// ERIR status requests of all data types belong to EndpointGroupErirStatus
+func endpointGroup(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+
+	if parts[len(parts)-1] == "erir_status" {
+		return EndpointGroupErirStatus
+	}
+
+	switch parts[1] {
+	case "get_media_info":
+		return EndpointGroupMedia
+	}
+
+	return parts[1]
+}
@@ -0,0 +1,121 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := NewRateLimiter(100, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+
+	// два запроса проходят сразу за счет burst, еще два ждут по 10ms
+	assert.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond)
+
+	stats := limiter.Stats()
+	assert.Equal(t, int64(4), stats.Requests)
+	assert.Equal(t, int64(2), stats.Waits)
+	assert.Greater(t, stats.WaitTime, time.Duration(0))
+}
+
+func TestRateLimiter_ContextCanceled(t *testing.T) {
+	limiter := NewRateLimiter(0.1, 1)
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := limiter.Wait(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/v1/media/") {
+			handleUploadMedia(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithRateLimit(200, 1),
+		WithGroupRateLimit(EndpointGroupStatistics, 100, 1),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.CreatePerson(context.Background(), "person1", Person{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	_, err = client.UploadMedia(context.Background(), "media1", "test.txt", strings.NewReader("test file content"))
+	require.NoError(t, err)
+
+	_, err = client.GetStatisticsList(context.Background(), 0, 10)
+	require.NoError(t, err)
+	_, err = client.GetStatisticsList(context.Background(), 0, 10)
+	require.NoError(t, err)
+
+	all := client.RateLimitStats("")
+	assert.Equal(t, int64(7), all.Requests)
+	assert.Greater(t, all.Waits, int64(0))
+
+	statistics := client.RateLimitStats(EndpointGroupStatistics)
+	assert.Equal(t, int64(2), statistics.Requests)
+
+	assert.Equal(t, RateLimitStats{}, client.RateLimitStats(EndpointGroupDictionary))
+}
+
+func TestEndpointGroup(t *testing.T) {
+	tests := []struct {
+		path  string
+		group string
+	}{
+		{"/v3/statistics/list?offset=0&limit=10", EndpointGroupStatistics},
+		{"/v1/dict/kktu", EndpointGroupDictionary},
+		{"/v1/get_media_info", EndpointGroupMedia},
+		{"/v4/invoice/inv1/header", EndpointGroupInvoice},
+		{"/v1/person/person1", EndpointGroupPerson},
+		{"/v1/person/person1/erir_status", EndpointGroupErirStatus},
+		{"/v1/contract/contract1/erir_status", EndpointGroupErirStatus},
+		{"/v1/erir_statuses?data_type=person", EndpointGroupErirStatus},
+		{"/", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.group, endpointGroup(tt.path))
+		})
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	_, err := NewClient(WithRateLimit(0, 1))
+	require.Error(t, err)
+
+	_, err = NewClient(WithGroupRateLimit(EndpointGroupPerson, -1, 1))
+	require.Error(t, err)
+}
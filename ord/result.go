@@ -0,0 +1,70 @@
+package ord
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownFields is returned by create methods with WithStrictInfoMessages when the API
+// reports fields of the request it doesn't know
+var ErrUnknownFields = errors.New("unknown fields in request")
+
+// InfoMessageField is a field mentioned in an info message
+type InfoMessageField struct {
+	Field  string          `json:"field"`
+	Values json.RawMessage `json:"values,omitempty"`
+}
+
+// InfoMessage is additional information returned with 2xx responses, e.g. about unknown fields
+type InfoMessage struct {
+	Text   string             `json:"text"`
+	Fields []InfoMessageField `json:"fields,omitempty"`
+}
+
+// IsUnknownField reports whether the message is about unknown fields or values in the request
+func (m InfoMessage) IsUnknownField() bool {
+	return strings.Contains(strings.ToLower(m.Text), "unknown")
+}
+
+func (m InfoMessage) String() string {
+	if len(m.Fields) == 0 {
+		return m.Text
+	}
+
+	fields := make([]string, 0, len(m.Fields))
+	for _, f := range m.Fields {
+		fields = append(fields, f.Field)
+	}
+
+	return fmt.Sprintf("%s: %s", m.Text, strings.Join(fields, ", "))
+}
+
+// PutResult is the response of PUT methods creating or updating objects
+type PutResult struct {
+	Messages []InfoMessage `json:"messages,omitempty"`
+	ERID     string        `json:"erid,omitempty"`   // токен креатива, только для креативов.
+	Marker   string        `json:"marker,omitempty"` // устаревший синоним erid в v1 и v2.
+}
+
+// checkInfoMessages returns ErrUnknownFields if strict info messages are enabled
+// and the result has messages about unknown fields
+func (c *Client) checkInfoMessages(result *PutResult) error {
+	if !c.strictInfoMessages {
+		return nil
+	}
+
+	var unknown []string
+	for _, m := range result.Messages {
+		if m.IsUnknownField() {
+			unknown = append(unknown, m.String())
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownFields, strings.Join(unknown, "; "))
+	}
+
+	return nil
+}
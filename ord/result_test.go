@@ -0,0 +1,76 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const unknownFieldsResponse = `{
+	"erid": "Pb3XmBtzsxtPgHUnh4hEFkxvF6zeSzBGKBqpCEz",
+	"messages": [{"text": "Unknown fields ignored", "fields": [{"field": "kktu", "values": {"kktu": "1.1"}}]}]
+}`
+
+func TestClient_CreateCreativeV3_Result(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, unknownFieldsResponse)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+	)
+
+	result, err := client.CreateCreativeV3(context.Background(), "creative1", CreateCreativeV3Request{})
+	require.NoError(t, err)
+	assert.Equal(t, "Pb3XmBtzsxtPgHUnh4hEFkxvF6zeSzBGKBqpCEz", result.ERID)
+	require.Len(t, result.Messages, 1)
+	assert.True(t, result.Messages[0].IsUnknownField())
+	assert.Equal(t, "Unknown fields ignored: kktu", result.Messages[0].String())
+	assert.JSONEq(t, `{"kktu": "1.1"}`, string(result.Messages[0].Fields[0].Values))
+}
+
+func TestClient_CreatePerson_NoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithStrictInfoMessages(),
+	)
+
+	result, err := client.CreatePerson(context.Background(), "person1", Person{})
+	require.NoError(t, err)
+	assert.Empty(t, result.Messages)
+}
+
+func TestWithStrictInfoMessages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, unknownFieldsResponse)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithStrictInfoMessages(),
+	)
+
+	result, err := client.CreateContract(context.Background(), "contract1", CreateContractRequest{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnknownFields)
+	assert.Contains(t, err.Error(), "kktu")
+	require.NotNil(t, result, "result is returned together with the error")
+	assert.Len(t, result.Messages, 1)
+}
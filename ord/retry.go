@@ -0,0 +1,128 @@
+package ord
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how transient failures are retried.
+// By default only idempotent requests (GET, PUT, DELETE) are retried,
+// POST requests are retried only when the context is marked with ContextWithRetry.
+type RetryPolicy struct {
+	MaxAttempts    int           // общее количество попыток, включая первую.
+	InitialBackoff time.Duration // задержка перед первым повтором.
+	MaxBackoff     time.Duration // максимальная задержка между попытками.
+	RetryStatuses  []int         // HTTP-статусы, при которых запрос повторяется.
+}
+
+// DefaultRetryPolicy returns a policy with 3 attempts and backoff from 500ms to 10s
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		RetryStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+type retryKey struct{}
+
+// ContextWithRetry marks the request as safe to retry even if it is not idempotent,
+// for example CreateStatisticsV3
+func ContextWithRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryKey{}, true)
+}
+
+func (p *RetryPolicy) allowed(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	retry, _ := ctx.Value(retryKey{}).(bool)
+
+	return retry
+}
+
+func (p *RetryPolicy) retryable(err error, statusCode int) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	for _, s := range p.RetryStatuses {
+		if s == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the next attempt using exponential backoff with jitter,
+// Retry-After from the response is used instead but not longer than MaxBackoff
+func (p *RetryPolicy) backoff(attempt int, header http.Header) time.Duration {
+	if d, ok := parseRetryAfter(header.Get("Retry-After")); ok {
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return d
+	}
+
+	d := p.InitialBackoff << attempt
+	if d <= 0 || (p.MaxBackoff > 0 && d > p.MaxBackoff) {
+		d = p.MaxBackoff
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + rand.N(d/2+1)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+
+		return d, true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,231 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	return policy
+}
+
+func TestClient_Retry_Idempotent(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		json.NewEncoder(w).Encode(Contract{Type: ContractTypeService})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithRetry(testRetryPolicy()),
+	)
+
+	contract, err := client.GetContract(context.Background(), "contract1")
+	require.NoError(t, err)
+	assert.Equal(t, ContractTypeService, contract.Type)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_Retry_Exhausted(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithRetry(testRetryPolicy()),
+	)
+
+	err := client.DeleteInvoice(context.Background(), "invoice1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 503")
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_Retry_NotRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithRetry(testRetryPolicy()),
+	)
+
+	_, err := client.GetPerson(context.Background(), "person1")
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_Retry_Post(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"external_ids": ["stat1"]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithRetry(testRetryPolicy()),
+	)
+
+	statistics := StatisticsV3ItemsArray{Items: []StatisticsV3Item{{}}}
+
+	_, err := client.CreateStatisticsV3(context.Background(), statistics)
+	require.Error(t, err, "POST should not be retried by default")
+	assert.Equal(t, int32(1), calls.Load())
+
+	calls.Store(0)
+	ids, err := client.CreateStatisticsV3(ContextWithRetry(context.Background()), statistics)
+	require.NoError(t, err)
+	assert.Equal(t, []StatisticsExternalID{"stat1"}, ids)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_Retry_UploadMedia(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		handleUploadMedia(w, r)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithRetry(testRetryPolicy()),
+	)
+
+	sha256, err := client.UploadMedia(context.Background(), "test-media", "test.txt", strings.NewReader("test file content"))
+	require.NoError(t, err)
+	assert.Equal(t, "test-sha256", *sha256)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_Retry_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxBackoff = time.Minute
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("test-token"),
+		WithRetry(policy),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetPads(ctx, 0, 10, "")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := parseRetryAfter("2")
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, d)
+
+	d, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
+
+func TestRetryPolicy_Backoff_RetryAfter(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Second}
+
+	assert.Equal(t, 2*time.Second, policy.backoff(0, http.Header{"Retry-After": []string{"2"}}))
+	assert.Equal(t, 10*time.Second, policy.backoff(0, http.Header{"Retry-After": []string{"86400"}}), "Retry-After should be capped by MaxBackoff")
+
+	policy.MaxBackoff = 0
+	assert.Equal(t, 86400*time.Second, policy.backoff(0, http.Header{"Retry-After": []string{"86400"}}))
+}
+
+func TestClient_Retry_RetryAfterCapped(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "86400")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxBackoff = 10 * time.Millisecond
+
+	client, _ := NewClient(WithBase(server.URL), WithToken("test-token"), WithRetry(policy))
+
+	start := time.Now()
+	_, err := client.GetPad(context.Background(), "pad1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestWithRetry(t *testing.T) {
+	client, err := NewClient(WithRetry(DefaultRetryPolicy()))
+	require.NoError(t, err)
+	require.NotNil(t, client.retry)
+	assert.Equal(t, 3, client.retry.MaxAttempts)
+
+	_, err = NewClient(WithRetry(RetryPolicy{}))
+	require.Error(t, err)
+}
@@ -2,28 +2,88 @@ package ord
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 )
 
+// StatisticsPayType is the pricing model of a statistics item
+type StatisticsPayType string
+
 const (
-	StatisticsPayTypeCPA   = "cpa"   // Cost Per Action, цена за действие.
-	StatisticsPayTypeCPC   = "cpc"   // Cost Per Click, цена за клик.
-	StatisticsPayTypeCPM   = "cpm"   // Cost Per Millennium, цена за 1 000 показов.
-	PStatisticsayTypeOther = "other" // иное.
+	StatisticsPayTypeCPA   StatisticsPayType = "cpa"   // Cost Per Action, цена за действие.
+	StatisticsPayTypeCPC   StatisticsPayType = "cpc"   // Cost Per Click, цена за клик.
+	StatisticsPayTypeCPM   StatisticsPayType = "cpm"   // Cost Per Millennium, цена за 1 000 показов.
+	StatisticsPayTypeOther StatisticsPayType = "other" // иное.
+
+	// Deprecated: use StatisticsPayTypeOther.
+	PStatisticsayTypeOther = StatisticsPayTypeOther
 )
 
+// AllStatisticsPayTypes returns all known statistics pay types
+func AllStatisticsPayTypes() []StatisticsPayType {
+	return []StatisticsPayType{StatisticsPayTypeCPA, StatisticsPayTypeCPC, StatisticsPayTypeCPM, StatisticsPayTypeOther}
+}
+
+// ParseStatisticsPayType returns an error for unknown statistics pay types
+func ParseStatisticsPayType(s string) (StatisticsPayType, error) {
+	return parseEnum("statistics pay type", s, AllStatisticsPayTypes())
+}
+
+func (t StatisticsPayType) Valid() bool {
+	return slices.Contains(AllStatisticsPayTypes(), t)
+}
+
+func (t StatisticsPayType) String() string {
+	return string(t)
+}
+
 type StatisticsV2Item struct {
-	CreativeExternalID string            `json:"creative_external_id"`
-	PadExternalID      string            `json:"pad_external_id"`
-	ShowsCount         uint64            `json:"shows_count"`
-	InvoiceShowsCount  *uint64           `json:"invoice_shows_count,omitempty"`
-	Amount             *StatisticsAmount `json:"amount,omitempty"`
-	AmountPerEvent     *string           `json:"amount_per_event,omitempty"`
-	PayType            *string           `json:"pay_type,omitempty"`
-	DateStartPlanned   *string           `json:"date_start_planned,omitempty"`
-	DateEndPlanned     *string           `json:"date_end_planned,omitempty"`
-	DateStartActual    string            `json:"date_start_actual"`
-	DateEndActual      string            `json:"date_end_actual"`
+	CreativeExternalID string             `json:"creative_external_id"`
+	PadExternalID      string             `json:"pad_external_id"`
+	ShowsCount         uint64             `json:"shows_count"`
+	InvoiceShowsCount  *uint64            `json:"invoice_shows_count,omitempty"`
+	Amount             *StatisticsAmount  `json:"amount,omitempty"`
+	AmountPerEvent     *string            `json:"amount_per_event,omitempty"`
+	PayType            *StatisticsPayType `json:"pay_type,omitempty"`
+	DateStartPlanned   *string            `json:"date_start_planned,omitempty"`
+	DateEndPlanned     *string            `json:"date_end_planned,omitempty"`
+	DateStartActual    string             `json:"date_start_actual"`
+	DateEndActual      string             `json:"date_end_actual"`
+}
+
+// StatisticsItem is StatisticsV2Item with typed dates
+type StatisticsItem struct {
+	CreativeExternalID string
+	PadExternalID      string
+	ShowsCount         uint64
+	InvoiceShowsCount  *uint64
+	Amount             *StatisticsAmount
+	AmountPerEvent     *string
+	PayType            *StatisticsPayType
+	DateStartPlanned   *Date
+	DateEndPlanned     *Date
+	DateStartActual    Date
+	DateEndActual      Date
+}
+
+// Item converts the typed item into StatisticsV2Item
+func (i StatisticsItem) Item() StatisticsV2Item {
+	return StatisticsV2Item{
+		CreativeExternalID: i.CreativeExternalID,
+		PadExternalID:      i.PadExternalID,
+		ShowsCount:         i.ShowsCount,
+		InvoiceShowsCount:  i.InvoiceShowsCount,
+		Amount:             i.Amount,
+		AmountPerEvent:     i.AmountPerEvent,
+		PayType:            i.PayType,
+		DateStartPlanned:   dateString(i.DateStartPlanned),
+		DateEndPlanned:     dateString(i.DateEndPlanned),
+		DateStartActual:    i.DateStartActual.String(),
+		DateEndActual:      i.DateEndActual.String(),
+	}
 }
 
 type StatisticsAmount struct {
@@ -33,8 +93,199 @@ type StatisticsAmount struct {
 	IncludingVAT string `json:"including_vat"`
 }
 
+// StatisticsVatRate is a VAT rate of StatisticsV3Amount, 22 replaces 20 for invoices dated 2026-01-01 and later
+type StatisticsVatRate string
+
+const (
+	StatisticsVatRateWithoutVat StatisticsVatRate = "without_vat" // без НДС.
+	StatisticsVatRate0          StatisticsVatRate = "0"           // 0%.
+	StatisticsVatRate5          StatisticsVatRate = "5"           // 5%.
+	StatisticsVatRate7          StatisticsVatRate = "7"           // 7%.
+	StatisticsVatRate10         StatisticsVatRate = "10"          // 10%.
+	StatisticsVatRate20         StatisticsVatRate = "20"          // 20%, для актов до 2026-01-01.
+	StatisticsVatRate22         StatisticsVatRate = "22"          // 22%, для актов с 2026-01-01.
+)
+
+// AllStatisticsVatRates returns all VAT rates accepted in v3 statistics
+func AllStatisticsVatRates() []StatisticsVatRate {
+	return []StatisticsVatRate{
+		StatisticsVatRateWithoutVat,
+		StatisticsVatRate0,
+		StatisticsVatRate5,
+		StatisticsVatRate7,
+		StatisticsVatRate10,
+		StatisticsVatRate20,
+		StatisticsVatRate22,
+	}
+}
+
+// ParseStatisticsVatRate returns an error for VAT rates not accepted in v3 statistics,
+// equal numbers like "20.00" are normalized to "20"
+func ParseStatisticsVatRate(s string) (StatisticsVatRate, error) {
+	if rate, err := ParseMoney(s); err == nil {
+		s = rate.trim(0).String()
+	}
+
+	return parseEnum("statistics vat rate", s, AllStatisticsVatRates())
+}
+
+func (r StatisticsVatRate) Valid() bool {
+	return slices.Contains(AllStatisticsVatRates(), r)
+}
+
+func (r StatisticsVatRate) String() string {
+	return string(r)
+}
+
+// StatisticsV3Amount is the amount of StatisticsV3Item, all sums have at most 5 fractional digits
+type StatisticsV3Amount struct {
+	ExcludingVAT Money             `json:"excluding_vat"`
+	VATRate      StatisticsVatRate `json:"vat_rate"`
+	VAT          Money             `json:"vat"`
+	IncludingVAT Money             `json:"including_vat"`
+}
+
+// MarshalJSON drops trailing zeros beyond 5 fractional digits, e.g. NewMoney(10000000000, 8)
+// is sent as "100.00000", the API rejects longer fractions
+func (a StatisticsV3Amount) MarshalJSON() ([]byte, error) {
+	type amount StatisticsV3Amount
+
+	a.ExcludingVAT = a.ExcludingVAT.trim(statisticsAmountScale)
+	a.VAT = a.VAT.trim(statisticsAmountScale)
+	a.IncludingVAT = a.IncludingVAT.trim(statisticsAmountScale)
+
+	return json.Marshal(amount(a))
+}
+
+// validate checks the scale of the sums, the VAT rate, that excluding_vat + vat = including_vat
+// and that vat matches vat_rate with the tolerance described in the API docs
+func (a StatisticsV3Amount) validate(v *validator, path string) {
+	sums := []struct {
+		field string
+		value Money
+	}{
+		{"excluding_vat", a.ExcludingVAT},
+		{"vat", a.VAT},
+		{"including_vat", a.IncludingVAT},
+	}
+	valid := true
+	for _, sum := range sums {
+		switch {
+		case sum.value.Sign() < 0:
+			v.add(path+"."+sum.field, "must not be negative")
+			valid = false
+		case sum.value.trim(0).Scale() > statisticsAmountScale:
+			v.add(path+"."+sum.field, "must have at most %d fractional digits", statisticsAmountScale)
+			valid = false
+		}
+	}
+
+	if !a.VATRate.Valid() {
+		v.add(path+".vat_rate", "unknown vat rate %q", a.VATRate)
+		return
+	}
+	if !valid {
+		return
+	}
+
+	if a.ExcludingVAT.Add(a.VAT).Cmp(a.IncludingVAT) != 0 {
+		v.add(path+".including_vat", "must be equal to excluding_vat + vat")
+	}
+
+	if a.VATRate == StatisticsVatRateWithoutVat {
+		if !a.VAT.IsZero() {
+			v.add(path+".vat", "must be 0 for %s", StatisticsVatRateWithoutVat)
+		}
+		return
+	}
+
+	lo, hi := vatRange(a.ExcludingVAT, MustParseMoney(a.VATRate.String()))
+	if a.VAT.Cmp(lo) < 0 || a.VAT.Cmp(hi) > 0 {
+		v.add(path+".vat", "must be between %s and %s for vat_rate %s", lo, hi, a.VATRate)
+	}
+}
+
+// V3 converts the v2 amount, sums with more than 5 significant fractional digits and VAT rates
+// unknown to v3 are reported as errors instead of being rounded
+func (a StatisticsAmount) V3() (StatisticsV3Amount, error) {
+	v := &validator{}
+	amount := a.v3(v, "amount")
+
+	return amount, v.err()
+}
+
+func (a StatisticsAmount) v3(v *validator, path string) StatisticsV3Amount {
+	sum := func(field, value string) Money {
+		m, err := ParseMoney(value)
+		m = m.trim(statisticsAmountScale)
+		switch {
+		case err != nil || m.Sign() < 0:
+			v.add(path+"."+field, "must be a non-negative decimal number")
+		case m.Scale() > statisticsAmountScale:
+			v.add(path+"."+field, "%s has more than %d fractional digits", value, statisticsAmountScale)
+		}
+
+		return m
+	}
+
+	amount := StatisticsV3Amount{
+		ExcludingVAT: sum("excluding_vat", a.ExcludingVAT),
+		VAT:          sum("vat", a.VAT),
+		IncludingVAT: sum("including_vat", a.IncludingVAT),
+	}
+
+	rate, err := ParseStatisticsVatRate(a.VATRate)
+	if err != nil {
+		v.add(path+".vat_rate", "vat rate %q is not accepted in v3 statistics", a.VATRate)
+	}
+	amount.VATRate = rate
+
+	return amount
+}
+
+// StatisticsV3Item is an item of v3 statistics, it differs from StatisticsV2Item in the amount model
 type StatisticsV3Item struct {
-	StatisticsV2Item
+	CreativeExternalID string              `json:"creative_external_id"`
+	PadExternalID      string              `json:"pad_external_id"`
+	ShowsCount         uint64              `json:"shows_count"`
+	InvoiceShowsCount  *uint64             `json:"invoice_shows_count,omitempty"`
+	Amount             *StatisticsV3Amount `json:"amount,omitempty"`
+	AmountPerEvent     *string             `json:"amount_per_event,omitempty"`
+	PayType            *StatisticsPayType  `json:"pay_type,omitempty"`
+	DateStartPlanned   *string             `json:"date_start_planned,omitempty"`
+	DateEndPlanned     *string             `json:"date_end_planned,omitempty"`
+	DateStartActual    string              `json:"date_start_actual"`
+	DateEndActual      string              `json:"date_end_actual"`
+}
+
+// V3 converts the v2 item into StatisticsV3Item, see StatisticsAmount.V3
+func (i StatisticsV2Item) V3() (StatisticsV3Item, error) {
+	v := &validator{}
+	item := i.v3(v, "")
+
+	return item, v.err()
+}
+
+func (i StatisticsV2Item) v3(v *validator, prefix string) StatisticsV3Item {
+	item := StatisticsV3Item{
+		CreativeExternalID: i.CreativeExternalID,
+		PadExternalID:      i.PadExternalID,
+		ShowsCount:         i.ShowsCount,
+		InvoiceShowsCount:  i.InvoiceShowsCount,
+		AmountPerEvent:     i.AmountPerEvent,
+		PayType:            i.PayType,
+		DateStartPlanned:   i.DateStartPlanned,
+		DateEndPlanned:     i.DateEndPlanned,
+		DateStartActual:    i.DateStartActual,
+		DateEndActual:      i.DateEndActual,
+	}
+
+	if i.Amount != nil {
+		amount := i.Amount.v3(v, prefix+"amount")
+		item.Amount = &amount
+	}
+
+	return item
 }
 
 type StatisticsV2ItemsArray struct {
@@ -45,6 +296,35 @@ type StatisticsV3ItemsArray struct {
 	Items []StatisticsV3Item `json:"items"`
 }
 
+// V3 converts all items, errors are reported with paths like items[0].amount.vat
+func (a StatisticsV2ItemsArray) V3() (StatisticsV3ItemsArray, error) {
+	v := &validator{}
+
+	items := make([]StatisticsV3Item, 0, len(a.Items))
+	for n, item := range a.Items {
+		items = append(items, item.v3(v, fmt.Sprintf("items[%d].", n)))
+	}
+
+	return StatisticsV3ItemsArray{Items: items}, v.err()
+}
+
+// Validate checks amounts of all items, with WithValidation it runs before CreateStatisticsV3
+func (a StatisticsV3ItemsArray) Validate() error {
+	v := &validator{}
+
+	if len(a.Items) == 0 {
+		v.add("items", "is required")
+	}
+
+	for n, item := range a.Items {
+		if item.Amount != nil {
+			item.Amount.validate(v, fmt.Sprintf("items[%d].amount", n))
+		}
+	}
+
+	return v.err()
+}
+
 type StatisticsListResponse struct {
 	Items           []StatisticsV2Item `json:"items"`
 	TotalItemsCount int                `json:"total_items_count"`
@@ -67,7 +347,7 @@ func (c *Client) CreateStatisticsV2(ctx context.Context, statistics StatisticsV2
 	var response struct {
 		ExternalIDs []StatisticsExternalID `json:"external_ids"`
 	}
-	if err := c.request(ctx, "POST", path, statistics, &response); err != nil {
+	if err := c.request(ctx, "CreateStatisticsV2", "", "POST", path, statistics, &response); err != nil {
 		return nil, fmt.Errorf("failed to create statistics v2: %w", err)
 	}
 
@@ -80,7 +360,7 @@ func (c *Client) CreateStatisticsV3(ctx context.Context, statistics StatisticsV3
 	var response struct {
 		ExternalIDs []StatisticsExternalID `json:"external_ids"`
 	}
-	if err := c.request(ctx, "POST", path, statistics, &response); err != nil {
+	if err := c.request(ctx, "CreateStatisticsV3", "", "POST", path, statistics, &response); err != nil {
 		return nil, fmt.Errorf("failed to create statistics v3: %w", err)
 	}
 
@@ -88,10 +368,92 @@ func (c *Client) CreateStatisticsV3(ctx context.Context, statistics StatisticsV3
 }
 
 func (c *Client) GetStatisticsList(ctx context.Context, offset, limit int) (*StatisticsListResponse, error) {
+	return c.GetStatisticsListFiltered(ctx, StatisticsFilter{}, offset, limit)
+}
+
+// statisticsFilterMaxLength limits the length of each ID list in the query string,
+// longer lists are split into several requests by AllStatisticsFiltered
+const statisticsFilterMaxLength = 1500
+
+// StatisticsFilter filters the statistics list, filters are combined with AND
+// and values inside one filter with OR
+type StatisticsFilter struct {
+	Months              []Month
+	CreativeExternalIDs []string
+	PadExternalIDs      []string
+}
+
+// Query returns the filter as query parameters
+func (f StatisticsFilter) Query() url.Values {
+	params := url.Values{}
+
+	if len(f.Months) > 0 {
+		months := make([]string, 0, len(f.Months))
+		for _, m := range f.Months {
+			months = append(months, m.String())
+		}
+		params.Set("months", strings.Join(months, ","))
+	}
+	if len(f.CreativeExternalIDs) > 0 {
+		params.Set("creative_external_ids", strings.Join(f.CreativeExternalIDs, ","))
+	}
+	if len(f.PadExternalIDs) > 0 {
+		params.Set("pad_external_ids", strings.Join(f.PadExternalIDs, ","))
+	}
+
+	return params
+}
+
+// Chunks splits the filter into filters with short enough query strings, the union
+// of their results equals the result of the whole filter
+func (f StatisticsFilter) Chunks() []StatisticsFilter {
+	creatives := chunkIDs(f.CreativeExternalIDs, statisticsFilterMaxLength)
+	pads := chunkIDs(f.PadExternalIDs, statisticsFilterMaxLength)
+
+	chunks := make([]StatisticsFilter, 0, len(creatives)*len(pads))
+	for _, creative := range creatives {
+		for _, pad := range pads {
+			chunks = append(chunks, StatisticsFilter{Months: f.Months, CreativeExternalIDs: creative, PadExternalIDs: pad})
+		}
+	}
+
+	return chunks
+}
+
+// chunkIDs splits ids so that each comma separated chunk is not longer than maxLength,
+// it returns one nil chunk for an empty list
+func chunkIDs(ids []string, maxLength int) [][]string {
+	if len(ids) == 0 {
+		return [][]string{nil}
+	}
+
+	var chunks [][]string
+	var chunk []string
+	length := 0
+
+	for _, id := range ids {
+		size := len(url.QueryEscape(id)) + len("%2C")
+		if len(chunk) > 0 && length+size > maxLength {
+			chunks = append(chunks, chunk)
+			chunk, length = nil, 0
+		}
+		chunk = append(chunk, id)
+		length += size
+	}
+
+	return append(chunks, chunk)
+}
+
+// GetStatisticsListFiltered returns a page of statistics matching the filter, use
+// AllStatisticsFiltered for long lists of IDs
+func (c *Client) GetStatisticsListFiltered(ctx context.Context, filter StatisticsFilter, offset, limit int) (*StatisticsListResponse, error) {
 	path := fmt.Sprintf("/v3/statistics/list?offset=%d&limit=%d", offset, limit)
+	if query := filter.Query(); len(query) > 0 {
+		path += "&" + query.Encode()
+	}
 
 	var response StatisticsListResponse
-	if err := c.request(ctx, "GET", path, nil, &response); err != nil {
+	if err := c.request(ctx, "GetStatisticsList", "", "GET", path, nil, &response); err != nil {
 		return nil, fmt.Errorf("failed to get statistics list: %w", err)
 	}
 
@@ -101,7 +463,7 @@ func (c *Client) GetStatisticsList(ctx context.Context, offset, limit int) (*Sta
 func (c *Client) DeleteStatisticsV3(ctx context.Context, deleteReq DeleteStatisticsRequest) error {
 	path := "/v3/statistics/delete"
 
-	if err := c.request(ctx, "POST", path, deleteReq, nil); err != nil {
+	if err := c.request(ctx, "DeleteStatisticsV3", "", "POST", path, deleteReq, nil); err != nil {
 		return fmt.Errorf("failed to delete statistics: %w", err)
 	}
 
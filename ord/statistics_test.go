@@ -2,7 +2,12 @@ package ord
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_Statistics(t *testing.T) {
@@ -34,13 +39,11 @@ func TestClient_Statistics(t *testing.T) {
 		statistics := StatisticsV3ItemsArray{
 			Items: []StatisticsV3Item{
 				{
-					StatisticsV2Item: StatisticsV2Item{
-						CreativeExternalID: "creative-1",
-						PadExternalID:      "pad-1",
-						ShowsCount:         100,
-						DateStartActual:    "2023-01-01",
-						DateEndActual:      "2023-01-31",
-					},
+					CreativeExternalID: "creative-1",
+					PadExternalID:      "pad-1",
+					ShowsCount:         100,
+					DateStartActual:    "2023-01-01",
+					DateEndActual:      "2023-01-31",
 				},
 			},
 		}
@@ -79,3 +82,90 @@ func TestClient_Statistics(t *testing.T) {
 		}
 	})
 }
+
+func TestStatisticsFilter_Query(t *testing.T) {
+	filter := StatisticsFilter{
+		Months:              []Month{NewMonth(2023, time.April), NewMonth(2023, time.September)},
+		CreativeExternalIDs: []string{"c1", "c2"},
+	}
+
+	query := filter.Query()
+	assert.Equal(t, "2023-04-01,2023-09-01", query.Get("months"))
+	assert.Equal(t, "c1,c2", query.Get("creative_external_ids"))
+	assert.False(t, query.Has("pad_external_ids"))
+
+	assert.Len(t, StatisticsFilter{}.Chunks(), 1)
+}
+
+func TestStatisticsV2Item_V3(t *testing.T) {
+	item := StatisticsV2Item{
+		CreativeExternalID: "creative-1",
+		PadExternalID:      "pad-1",
+		ShowsCount:         100,
+		Amount:             &StatisticsAmount{ExcludingVAT: "0.1234500", VATRate: "20.00", VAT: "0.02469", IncludingVAT: "0.14814"},
+		DateStartActual:    "2023-01-01",
+		DateEndActual:      "2023-01-31",
+	}
+
+	v3, err := item.V3()
+	require.NoError(t, err)
+	assert.Equal(t, "creative-1", v3.CreativeExternalID)
+	assert.Equal(t, StatisticsVatRate20, v3.Amount.VATRate)
+
+	data, err := json.Marshal(v3.Amount)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"excluding_vat":"0.12345","vat_rate":"20","vat":"0.02469","including_vat":"0.14814"}`, string(data))
+
+	items := StatisticsV2ItemsArray{Items: []StatisticsV2Item{
+		item,
+		{Amount: &StatisticsAmount{ExcludingVAT: "0.123456", VATRate: "18", VAT: "0", IncludingVAT: "0.123456"}},
+	}}
+
+	_, err = items.V3()
+	var verrs ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+	assert.Len(t, verrs, 3)
+	assert.True(t, verrs.Has("items[1].amount.excluding_vat"))
+	assert.True(t, verrs.Has("items[1].amount.including_vat"))
+	assert.True(t, verrs.Has("items[1].amount.vat_rate"))
+}
+
+func TestStatisticsV3ItemsArray_Validate(t *testing.T) {
+	amount, err := StatisticsV3AmountFromGross(MustParseMoney("1.2"), StatisticsVatRate20)
+	require.NoError(t, err)
+	assert.Equal(t, "1.00000", amount.ExcludingVAT.String())
+	assert.Equal(t, "0.20000", amount.VAT.String())
+
+	valid := StatisticsV3ItemsArray{Items: []StatisticsV3Item{{Amount: &amount}}}
+	require.NoError(t, valid.Validate())
+
+	_, err = StatisticsV3AmountFromNet(MustParseMoney("1"), "18")
+	assert.Error(t, err)
+
+	invalid := StatisticsV3ItemsArray{Items: []StatisticsV3Item{
+		{Amount: &StatisticsV3Amount{ExcludingVAT: MustParseMoney("1.123456"), VATRate: StatisticsVatRate20, VAT: MustParseMoney("0.2"), IncludingVAT: MustParseMoney("1.323456")}},
+		{Amount: &StatisticsV3Amount{ExcludingVAT: MustParseMoney("100"), VATRate: StatisticsVatRate22, VAT: MustParseMoney("20"), IncludingVAT: MustParseMoney("120")}},
+		{Amount: &StatisticsV3Amount{ExcludingVAT: MustParseMoney("100"), VATRate: StatisticsVatRateWithoutVat, VAT: MustParseMoney("1"), IncludingVAT: MustParseMoney("100")}},
+	}}
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, invalid.Validate(), &verrs)
+	assert.True(t, verrs.Has("items[0].amount.excluding_vat"))
+	assert.True(t, verrs.Has("items[1].amount.vat"))
+	assert.True(t, verrs.Has("items[2].amount.vat"))
+	assert.True(t, verrs.Has("items[2].amount.including_vat"))
+
+	wide := StatisticsV3Amount{
+		ExcludingVAT: NewMoney(10000000000, 8),
+		VATRate:      StatisticsVatRate20,
+		VAT:          NewMoney(2000000000, 8),
+		IncludingVAT: MustParseMoney("120.1"),
+	}
+	data, err := json.Marshal(wide)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"excluding_vat":"100.00000","vat_rate":"20","vat":"20.00000","including_vat":"120.1"}`, string(data))
+
+	rate, err := ParseStatisticsVatRate("10.0")
+	require.NoError(t, err)
+	assert.Equal(t, StatisticsVatRate10, rate)
+}
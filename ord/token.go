@@ -0,0 +1,111 @@
+package ord
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenProvider returns the bearer token for each request.
+// Implementations must be safe for concurrent use
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenProviderFunc adapts a function to the TokenProvider interface
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+func (f TokenProviderFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticToken always returns the same token
+type StaticToken string
+
+func (t StaticToken) Token(ctx context.Context) (string, error) {
+	return string(t), nil
+}
+
+// EnvToken reads the token from the environment variable on each request
+type EnvToken string
+
+func (e EnvToken) Token(ctx context.Context) (string, error) {
+	token, ok := os.LookupEnv(string(e))
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", string(e))
+	}
+
+	return strings.TrimSpace(token), nil
+}
+
+// FileToken reads the token from the file and rereads it when the file is modified
+type FileToken struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+func NewFileToken(path string) *FileToken {
+	return &FileToken{path: path}
+}
+
+func (f *FileToken) Token(ctx context.Context) (string, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to stat token file: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.token != "" && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.token, nil
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	f.token = strings.TrimSpace(string(data))
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+
+	return f.token, nil
+}
+
+// SetToken replaces the token, it is safe to call while other goroutines use the client
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = token
+	c.tokens = nil
+}
+
+// SetTokenProvider replaces the token provider, it is safe to call while other goroutines use the client
+func (c *Client) SetTokenProvider(provider TokenProvider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = ""
+	c.tokens = provider
+}
+
+func (c *Client) authToken(ctx context.Context) (string, error) {
+	c.mu.RLock()
+	token, provider := c.token, c.tokens
+	c.mu.RUnlock()
+
+	if provider == nil {
+		return token, nil
+	}
+
+	return provider.Token(ctx)
+}
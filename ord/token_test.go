@@ -0,0 +1,157 @@
+//nolint:errcheck
+package ord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_TokenProvider(t *testing.T) {
+	var got []string
+	var mu sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		got = append(got, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithTokenProvider(StaticToken("provider-token")),
+	)
+
+	_, err := client.CreatePad(context.Background(), "pad1", Pad{})
+	require.NoError(t, err)
+
+	client.SetToken("static-token")
+	_, err = client.CreatePad(context.Background(), "pad1", Pad{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Bearer provider-token", "Bearer static-token"}, got)
+}
+
+func TestClient_TokenProvider_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	}))
+	defer server.Close()
+
+	failed := errors.New("vault is unavailable")
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithTokenProvider(TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return "", failed
+		})),
+	)
+
+	_, err := client.GetPad(context.Background(), "pad1")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, failed)
+}
+
+func TestClient_SetToken_Concurrent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(
+		WithBase(server.URL),
+		WithToken("token-0"),
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.SetToken(fmt.Sprintf("token-%d", i))
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.CreatePad(context.Background(), "pad1", Pad{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestEnvToken(t *testing.T) {
+	t.Setenv("ORD_TEST_TOKEN", "env-token\n")
+
+	token, err := EnvToken("ORD_TEST_TOKEN").Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "env-token", token)
+
+	_, err = EnvToken("ORD_TEST_TOKEN_MISSING").Token(context.Background())
+	require.Error(t, err)
+}
+
+func TestFileToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	provider := NewFileToken(path)
+
+	token, err := provider.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "first", token)
+
+	require.NoError(t, os.WriteFile(path, []byte("second-token\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Second)))
+
+	token, err = provider.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "second-token", token)
+
+	_, err = NewFileToken(filepath.Join(t.TempDir(), "missing")).Token(context.Background())
+	require.Error(t, err)
+}
+
+func TestWithTokenProvider(t *testing.T) {
+	client, err := NewClient()
+	require.NoError(t, err)
+
+	provider := StaticToken("test-token")
+	err = WithTokenProvider(provider)(client)
+	require.NoError(t, err)
+
+	assert.Equal(t, provider, client.tokens)
+}
+
+func TestTokenOptions_LastWins(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		want    string
+	}{
+		{"provider then token", []Option{WithTokenProvider(StaticToken("provider-token")), WithToken("static-token")}, "static-token"},
+		{"token then provider", []Option{WithToken("static-token"), WithTokenProvider(StaticToken("provider-token"))}, "provider-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(tt.options...)
+			require.NoError(t, err)
+
+			token, err := client.authToken(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, token)
+		})
+	}
+}
@@ -3,3 +3,8 @@ package ord
 func StringPtr(str string) *string {
 	return &str
 }
+
+// Ptr returns a pointer to v, it is handy for optional fields of typed enums
+func Ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,96 @@
+package ord
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// FieldError describes an invalid field of a request, Field is the JSON path, e.g. juridical_details.inn
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors is a list of field errors returned by Validate methods
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+// Has reports whether there is an error for the field
+func (e ValidationErrors) Has(field string) bool {
+	for _, fe := range e {
+		if fe.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validator collects field errors
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns nil when there are no errors, so the result can be compared with nil
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return v.errs
+}
+
+// validateBody runs Validate if the request body implements it
+func validateBody(body interface{}) error {
+	if v, ok := body.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
+
+var digitsRe = regexp.MustCompile(`^[0-9]+$`)
+
+// validINN checks length and checksum of a russian INN, 10 digits for organizations and 12 for individuals
+func validINN(inn string, length int) bool {
+	if len(inn) != length || !digitsRe.MatchString(inn) {
+		return false
+	}
+
+	d := make([]int, len(inn))
+	for i, r := range inn {
+		d[i] = int(r - '0')
+	}
+
+	checksum := func(weights []int) int {
+		sum := 0
+		for i, w := range weights {
+			sum += w * d[i]
+		}
+		return sum % 11 % 10
+	}
+
+	if length == 10 {
+		return checksum([]int{2, 4, 10, 3, 5, 9, 4, 6, 8}) == d[9]
+	}
+
+	return checksum([]int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}) == d[10] &&
+		checksum([]int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}) == d[11]
+}
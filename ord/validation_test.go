@@ -0,0 +1,118 @@
+package ord
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidINN(t *testing.T) {
+	assert.True(t, validINN("7707083893", 10))
+	assert.False(t, validINN("7707083894", 10))
+	assert.False(t, validINN("770708389", 10))
+	assert.True(t, validINN("500100732259", 12))
+	assert.False(t, validINN("500100732258", 12))
+	assert.False(t, validINN("50010073225a", 12))
+}
+
+func TestPerson_Validate(t *testing.T) {
+	valid := Person{
+		Name:  "ООО Ромашка",
+		Roles: []PersonRole{PersonRoleAdvertiser},
+		JuridicalDetails: JuridicalDetails{
+			Type:  PersonTypeJuridical,
+			INN:   "7707083893",
+			KPP:   Ptr("773601001"),
+			Phone: Ptr("+7 (495) 500-55-50"),
+		},
+	}
+	require.NoError(t, valid.Validate())
+
+	foreign := Person{
+		Name:  "Foreign Ltd",
+		Roles: []PersonRole{PersonRoleAgency},
+		JuridicalDetails: JuridicalDetails{
+			Type:                      PersonTypeForeignJuridical,
+			ForeignRegistrationNumber: Ptr("12345"),
+			ForeignOKSMCountryCode:    Ptr("840"),
+		},
+	}
+	require.NoError(t, foreign.Validate())
+
+	invalid := Person{
+		Roles: []PersonRole{"unknown", PersonRoleOrs},
+		JuridicalDetails: JuridicalDetails{
+			Type:                   PersonTypePhysical,
+			INN:                    "7707083893",
+			KPP:                    Ptr("773601001"),
+			Phone:                  Ptr("84955005550"),
+			ForeignINN:             Ptr("123"),
+			ForeignOKSMCountryCode: Ptr("840"),
+		},
+	}
+
+	err := invalid.Validate()
+	require.Error(t, err)
+
+	var verrs ValidationErrors
+	require.True(t, errors.As(err, &verrs))
+	for _, field := range []string{
+		"name",
+		"roles",
+		"rs_url",
+		"juridical_details.inn",
+		"juridical_details.kpp",
+		"juridical_details.phone",
+		"juridical_details.foreign_inn",
+		"juridical_details.foreign_oksm_country_code",
+	} {
+		assert.True(t, verrs.Has(field), field)
+	}
+	assert.False(t, verrs.Has("juridical_details.foreign_registration_number"))
+}
+
+func TestPerson_Validate_ForeignPhysical(t *testing.T) {
+	person := Person{
+		Name:  "John Doe",
+		Roles: []PersonRole{PersonRoleOrs},
+		RsURL: Ptr("https://example.com"),
+		JuridicalDetails: JuridicalDetails{
+			Type:                      PersonTypeForeignPhysical,
+			INN:                       "500100732259",
+			ForeignRegistrationNumber: Ptr("12345"),
+			ForeignOKSMCountryCode:    Ptr("US"),
+		},
+	}
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, person.Validate(), &verrs)
+	assert.True(t, verrs.Has("juridical_details.inn"))
+	assert.True(t, verrs.Has("juridical_details.foreign_registration_number"))
+	assert.True(t, verrs.Has("juridical_details.foreign_oksm_country_code"))
+	assert.True(t, verrs.Has("juridical_details.phone"))
+	assert.True(t, verrs.Has("juridical_details.foreign_inn"))
+	assert.Len(t, verrs, 5)
+}
+
+func TestPerson_Validate_ForeignPhysicalForeignINN(t *testing.T) {
+	person := Person{
+		Name:  "John Doe",
+		Roles: []PersonRole{PersonRoleAdvertiser},
+		JuridicalDetails: JuridicalDetails{
+			Type:                   PersonTypeForeignPhysical,
+			Phone:                  Ptr("+12025550100"),
+			ForeignINN:             Ptr("123456789"),
+			ForeignOKSMCountryCode: Ptr("840"),
+		},
+	}
+
+	var verrs ValidationErrors
+	require.ErrorAs(t, person.Validate(), &verrs)
+	assert.True(t, verrs.Has("juridical_details.foreign_inn"))
+	assert.Len(t, verrs, 1)
+
+	person.JuridicalDetails.ForeignINN = nil
+	assert.NoError(t, person.Validate())
+}